@@ -0,0 +1,69 @@
+// Package checkdigit provides check digit algorithms for financial identifiers such as card numbers.
+package checkdigit
+
+import (
+	"fmt"
+
+	"github.com/nduyhai/finarith/errors"
+	"github.com/nduyhai/finarith/safeint"
+)
+
+// Luhn reports whether number, a string of decimal digits ending in its check digit, passes the
+// Luhn (mod 10) check used by payment card numbers.
+// Returns ErrEmptyInput if number is empty, or an error wrapping ErrInvalidFormat if it contains
+// anything other than ASCII digits, including spaces or dashes.
+func Luhn(number string) (bool, error) {
+	sum, err := luhnSum(number, false)
+	if err != nil {
+		return false, err
+	}
+	return sum%10 == 0, nil
+}
+
+// GenerateLuhnCheckDigit returns the Luhn check digit to append to partial, a string of decimal
+// digits without its check digit, so that the result passes Luhn.
+// Returns ErrEmptyInput if partial is empty, or an error wrapping ErrInvalidFormat if it contains
+// anything other than ASCII digits.
+func GenerateLuhnCheckDigit(partial string) (int, error) {
+	sum, err := luhnSum(partial, true)
+	if err != nil {
+		return 0, err
+	}
+	return int((10 - sum%10) % 10), nil
+}
+
+// luhnSum returns the Luhn sum of the digits. Digits are doubled at every second position counting
+// from the right, starting with the rightmost digit when doubleFirst is set, which is the case when
+// the check digit is not yet present.
+func luhnSum(digits string, doubleFirst bool) (int64, error) {
+	if len(digits) == 0 {
+		return 0, errors.ErrEmptyInput
+	}
+
+	var sum int64
+	double := doubleFirst
+	for i := len(digits) - 1; i >= 0; i-- {
+		c := digits[i]
+		if c < '0' || c > '9' {
+			return 0, fmt.Errorf("%w: non-digit %q at position %d", errors.ErrInvalidFormat, c, i)
+		}
+
+		d := int64(c - '0')
+		if double {
+			// Doubling a digit gives at most 18, whose digits sum to the value minus 9
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		double = !double
+
+		var err error
+		sum, err = safeint.Add(sum, d)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return sum, nil
+}
@@ -0,0 +1,159 @@
+package checkdigit
+
+import (
+	"errors"
+	"testing"
+
+	finerrors "github.com/nduyhai/finarith/errors"
+)
+
+func TestLuhn(t *testing.T) {
+	tests := []struct {
+		name      string
+		number    string
+		want      bool
+		wantErr   bool
+		errorType error
+	}{
+		{
+			name:   "valid Visa test number",
+			number: "4111111111111111",
+			want:   true,
+		},
+		{
+			name:   "valid Mastercard test number",
+			number: "5500005555555559",
+			want:   true,
+		},
+		{
+			name:   "valid odd length number",
+			number: "79927398713",
+			want:   true,
+		},
+		{
+			name:   "wrong check digit",
+			number: "4111111111111112",
+			want:   false,
+		},
+		{
+			name:   "transposed digits",
+			number: "79927398731",
+			want:   false,
+		},
+		{
+			name:   "single zero",
+			number: "0",
+			want:   true,
+		},
+		{
+			name:      "empty",
+			number:    "",
+			wantErr:   true,
+			errorType: finerrors.ErrEmptyInput,
+		},
+		{
+			name:      "spaces",
+			number:    "4111 1111 1111 1111",
+			wantErr:   true,
+			errorType: finerrors.ErrInvalidFormat,
+		},
+		{
+			name:      "letter",
+			number:    "41111111111111a1",
+			wantErr:   true,
+			errorType: finerrors.ErrInvalidFormat,
+		},
+		{
+			name:      "sign",
+			number:    "-4111111111111111",
+			wantErr:   true,
+			errorType: finerrors.ErrInvalidFormat,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Luhn(tt.number)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Luhn() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				if !errors.Is(err, tt.errorType) {
+					t.Errorf("Luhn() error type = %v, want %v", err, tt.errorType)
+				}
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Luhn() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGenerateLuhnCheckDigit(t *testing.T) {
+	tests := []struct {
+		name      string
+		partial   string
+		want      int
+		wantErr   bool
+		errorType error
+	}{
+		{
+			name:    "Visa test number",
+			partial: "411111111111111",
+			want:    1,
+		},
+		{
+			name:    "Mastercard test number",
+			partial: "550000555555555",
+			want:    9,
+		},
+		{
+			name:    "odd length",
+			partial: "7992739871",
+			want:    3,
+		},
+		{
+			name:    "check digit zero",
+			partial: "0",
+			want:    0,
+		},
+		{
+			name:      "empty",
+			partial:   "",
+			wantErr:   true,
+			errorType: finerrors.ErrEmptyInput,
+		},
+		{
+			name:      "non-digit",
+			partial:   "4111-1111",
+			wantErr:   true,
+			errorType: finerrors.ErrInvalidFormat,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GenerateLuhnCheckDigit(tt.partial)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GenerateLuhnCheckDigit() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				if !errors.Is(err, tt.errorType) {
+					t.Errorf("GenerateLuhnCheckDigit() error type = %v, want %v", err, tt.errorType)
+				}
+				return
+			}
+			if got != tt.want {
+				t.Errorf("GenerateLuhnCheckDigit() = %v, want %v", got, tt.want)
+			}
+
+			valid, err := Luhn(tt.partial + string(rune('0'+got)))
+			if err != nil || !valid {
+				t.Errorf("Luhn() of generated number = %v, %v, want true", valid, err)
+			}
+		})
+	}
+}
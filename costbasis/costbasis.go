@@ -0,0 +1,127 @@
+// Package costbasis provides cost-basis tracking for capital gains using lot matching.
+package costbasis
+
+import (
+	"fmt"
+
+	"github.com/nduyhai/finarith/errors"
+	"github.com/nduyhai/finarith/safedec"
+)
+
+// Method represents the order in which lots are matched against a sale.
+type Method int
+
+// Lot matching methods
+const (
+	// FIFO matches the oldest lots first.
+	FIFO Method = iota
+
+	// LIFO matches the newest lots first.
+	LIFO
+)
+
+// String returns the string representation of the lot matching method.
+func (m Method) String() string {
+	switch m {
+	case FIFO:
+		return "fifo"
+	case LIFO:
+		return "lifo"
+	default:
+		return "unknown"
+	}
+}
+
+// Lot represents a quantity acquired at a single price.
+type Lot struct {
+	// Quantity is the quantity remaining in the lot.
+	Quantity safedec.Decimal
+
+	// PricePerUnit is the acquisition price of each unit.
+	PricePerUnit safedec.Decimal
+}
+
+// Ledger tracks the open lots of a single holding.
+type Ledger struct {
+	lots []Lot
+}
+
+// NewLedger creates a new Ledger with no lots.
+func NewLedger() *Ledger {
+	return &Ledger{}
+}
+
+// AddLot records the acquisition of quantity units at pricePerUnit.
+// Returns an error if quantity is not positive or if pricePerUnit is negative.
+func (l *Ledger) AddLot(quantity, pricePerUnit safedec.Decimal) error {
+	if !quantity.IsPositive() {
+		return errors.ErrInvalidArgument
+	}
+	if pricePerUnit.IsNegative() {
+		return errors.ErrNegativeValue
+	}
+
+	l.lots = append(l.lots, Lot{Quantity: quantity, PricePerUnit: pricePerUnit})
+	return nil
+}
+
+// Lots returns a copy of the open lots, oldest first.
+func (l *Ledger) Lots() []Lot {
+	lots := make([]Lot, len(l.lots))
+	copy(lots, l.lots)
+	return lots
+}
+
+// Quantity returns the total quantity held across all open lots.
+func (l *Ledger) Quantity() safedec.Decimal {
+	total := safedec.Zero()
+	for _, lot := range l.lots {
+		total = total.Add(lot.Quantity)
+	}
+	return total
+}
+
+// Sell disposes of quantity units at pricePerUnit, matching lots in the order given by method.
+// It returns the sale proceeds, the cost basis of the units sold, and the realized gain
+// (negative for a loss). Lots that are fully consumed are removed from the ledger.
+// Returns an error if quantity is not positive, if pricePerUnit is negative, if the method is
+// invalid, or if quantity exceeds the quantity held, in which case the ledger is unchanged.
+func (l *Ledger) Sell(quantity, pricePerUnit safedec.Decimal, method Method) (proceeds, basis, gain safedec.Decimal, err error) {
+	zero := safedec.Zero()
+	if !quantity.IsPositive() {
+		return zero, zero, zero, errors.ErrInvalidArgument
+	}
+	if pricePerUnit.IsNegative() {
+		return zero, zero, zero, errors.ErrNegativeValue
+	}
+	if method != FIFO && method != LIFO {
+		return zero, zero, zero, fmt.Errorf("%w: invalid cost basis method %d", errors.ErrInvalidArgument, method)
+	}
+
+	held := l.Quantity()
+	if quantity.GreaterThan(held) {
+		return zero, zero, zero, errors.NewLimitError(quantity.String(), held.String(), "quantity held")
+	}
+
+	// Consume lots from the front for FIFO or from the back for LIFO
+	remaining := quantity
+	basis = zero
+	for remaining.IsPositive() {
+		i := 0
+		if method == LIFO {
+			i = len(l.lots) - 1
+		}
+
+		matched := safedec.MinValue(remaining, l.lots[i].Quantity)
+		basis = basis.Add(matched.Mul(l.lots[i].PricePerUnit))
+		remaining = remaining.Sub(matched)
+
+		l.lots[i].Quantity = l.lots[i].Quantity.Sub(matched)
+		if l.lots[i].Quantity.IsZero() {
+			l.lots = append(l.lots[:i], l.lots[i+1:]...)
+		}
+	}
+
+	proceeds = quantity.Mul(pricePerUnit)
+	return proceeds, basis, proceeds.Sub(basis), nil
+}
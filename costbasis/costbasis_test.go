@@ -0,0 +1,236 @@
+package costbasis
+
+import (
+	"errors"
+	"testing"
+
+	finerrors "github.com/nduyhai/finarith/errors"
+	"github.com/nduyhai/finarith/safedec"
+)
+
+func TestMethod_String(t *testing.T) {
+	tests := []struct {
+		method Method
+		want   string
+	}{
+		{FIFO, "fifo"},
+		{LIFO, "lifo"},
+		{Method(99), "unknown"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := tt.method.String(); got != tt.want {
+				t.Errorf("Method.String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLedger_AddLot(t *testing.T) {
+	tests := []struct {
+		name         string
+		quantity     string
+		pricePerUnit string
+		wantErr      bool
+		errorType    error
+	}{
+		{
+			name:         "valid lot",
+			quantity:     "10",
+			pricePerUnit: "100.50",
+		},
+		{
+			name:         "zero price",
+			quantity:     "10",
+			pricePerUnit: "0",
+		},
+		{
+			name:         "zero quantity",
+			quantity:     "0",
+			pricePerUnit: "100",
+			wantErr:      true,
+			errorType:    finerrors.ErrInvalidArgument,
+		},
+		{
+			name:         "negative quantity",
+			quantity:     "-1",
+			pricePerUnit: "100",
+			wantErr:      true,
+			errorType:    finerrors.ErrInvalidArgument,
+		},
+		{
+			name:         "negative price",
+			quantity:     "10",
+			pricePerUnit: "-100",
+			wantErr:      true,
+			errorType:    finerrors.ErrNegativeValue,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := NewLedger()
+			quantity, _ := safedec.NewFromString(tt.quantity)
+			price, _ := safedec.NewFromString(tt.pricePerUnit)
+
+			err := l.AddLot(quantity, price)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("AddLot() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil && !errors.Is(err, tt.errorType) {
+				t.Errorf("AddLot() error = %v, want error type %v", err, tt.errorType)
+			}
+			wantLots := 1
+			if tt.wantErr {
+				wantLots = 0
+			}
+			if got := len(l.Lots()); got != wantLots {
+				t.Errorf("Lots() len = %v, want %v", got, wantLots)
+			}
+		})
+	}
+}
+
+func TestLedger_Sell(t *testing.T) {
+	tests := []struct {
+		name          string
+		quantity      string
+		pricePerUnit  string
+		method        Method
+		wantProceeds  string
+		wantBasis     string
+		wantGain      string
+		wantRemaining []Lot
+		wantErr       bool
+		errorType     error
+	}{
+		{
+			name:         "fifo sale spanning two lots",
+			quantity:     "15",
+			pricePerUnit: "200",
+			method:       FIFO,
+			wantProceeds: "3000",
+			wantBasis:    "1750",
+			wantGain:     "1250",
+			wantRemaining: []Lot{
+				{Quantity: safedec.NewFromInt(5), PricePerUnit: safedec.NewFromInt(150)},
+			},
+		},
+		{
+			name:         "lifo sale spanning two lots",
+			quantity:     "15",
+			pricePerUnit: "200",
+			method:       LIFO,
+			wantProceeds: "3000",
+			wantBasis:    "2000",
+			wantGain:     "1000",
+			wantRemaining: []Lot{
+				{Quantity: safedec.NewFromInt(5), PricePerUnit: safedec.NewFromInt(100)},
+			},
+		},
+		{
+			name:         "lifo sale at a loss",
+			quantity:     "4",
+			pricePerUnit: "120.25",
+			method:       LIFO,
+			wantProceeds: "481",
+			wantBasis:    "600",
+			wantGain:     "-119",
+			wantRemaining: []Lot{
+				{Quantity: safedec.NewFromInt(10), PricePerUnit: safedec.NewFromInt(100)},
+				{Quantity: safedec.NewFromInt(6), PricePerUnit: safedec.NewFromInt(150)},
+			},
+		},
+		{
+			name:          "sell entire holding",
+			quantity:      "20",
+			pricePerUnit:  "100",
+			method:        FIFO,
+			wantProceeds:  "2000",
+			wantBasis:     "2500",
+			wantGain:      "-500",
+			wantRemaining: []Lot{},
+		},
+		{
+			name:         "sell more than held",
+			quantity:     "20.5",
+			pricePerUnit: "200",
+			method:       FIFO,
+			wantErr:      true,
+			errorType:    finerrors.ErrExceedsLimit,
+		},
+		{
+			name:         "zero quantity",
+			quantity:     "0",
+			pricePerUnit: "200",
+			method:       FIFO,
+			wantErr:      true,
+			errorType:    finerrors.ErrInvalidArgument,
+		},
+		{
+			name:         "negative price",
+			quantity:     "1",
+			pricePerUnit: "-200",
+			method:       FIFO,
+			wantErr:      true,
+			errorType:    finerrors.ErrNegativeValue,
+		},
+		{
+			name:         "invalid method",
+			quantity:     "1",
+			pricePerUnit: "200",
+			method:       Method(99),
+			wantErr:      true,
+			errorType:    finerrors.ErrInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := NewLedger()
+			_ = l.AddLot(safedec.NewFromInt(10), safedec.NewFromInt(100))
+			_ = l.AddLot(safedec.NewFromInt(10), safedec.NewFromInt(150))
+
+			quantity, _ := safedec.NewFromString(tt.quantity)
+			price, _ := safedec.NewFromString(tt.pricePerUnit)
+
+			proceeds, basis, gain, err := l.Sell(quantity, price, tt.method)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Sell() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				if tt.errorType != nil && !errors.Is(err, tt.errorType) {
+					t.Errorf("Sell() error = %v, want error type %v", err, tt.errorType)
+				}
+				if got := l.Quantity(); got.String() != "20" {
+					t.Errorf("Quantity() after failed Sell() = %v, want 20", got.String())
+				}
+				return
+			}
+
+			if proceeds.String() != tt.wantProceeds {
+				t.Errorf("Sell() proceeds = %v, want %v", proceeds.String(), tt.wantProceeds)
+			}
+			if basis.String() != tt.wantBasis {
+				t.Errorf("Sell() basis = %v, want %v", basis.String(), tt.wantBasis)
+			}
+			if gain.String() != tt.wantGain {
+				t.Errorf("Sell() gain = %v, want %v", gain.String(), tt.wantGain)
+			}
+
+			lots := l.Lots()
+			if len(lots) != len(tt.wantRemaining) {
+				t.Fatalf("Lots() len = %v, want %v", len(lots), len(tt.wantRemaining))
+			}
+			for i, lot := range lots {
+				if !lot.Quantity.Equal(tt.wantRemaining[i].Quantity) || !lot.PricePerUnit.Equal(tt.wantRemaining[i].PricePerUnit) {
+					t.Errorf("Lots()[%d] = %v @ %v, want %v @ %v", i, lot.Quantity, lot.PricePerUnit,
+						tt.wantRemaining[i].Quantity, tt.wantRemaining[i].PricePerUnit)
+				}
+			}
+		})
+	}
+}
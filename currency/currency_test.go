@@ -0,0 +1,98 @@
+package currency
+
+import (
+	"errors"
+	"testing"
+
+	finerrors "github.com/nduyhai/finarith/errors"
+)
+
+func TestMinorUnit(t *testing.T) {
+	tests := []struct {
+		name    string
+		code    string
+		want    int32
+		wantErr bool
+	}{
+		{
+			name: "US dollar",
+			code: "USD",
+			want: 2,
+		},
+		{
+			name: "Japanese yen",
+			code: "JPY",
+			want: 0,
+		},
+		{
+			name: "Kuwaiti dinar",
+			code: "KWD",
+			want: 3,
+		},
+		{
+			name: "Icelandic krona",
+			code: "ISK",
+			want: 0,
+		},
+		{
+			name: "Kazakhstani tenge",
+			code: "KZT",
+			want: 2,
+		},
+		{
+			name: "Nigerian naira",
+			code: "NGN",
+			want: 2,
+		},
+		{
+			name: "Chilean unit of account",
+			code: "CLF",
+			want: 4,
+		},
+		{
+			name: "lower case code",
+			code: "eur",
+			want: 2,
+		},
+		{
+			name:    "unknown currency",
+			code:    "XYZ",
+			wantErr: true,
+		},
+		{
+			name:    "precious metal without a minor unit",
+			code:    "XAU",
+			wantErr: true,
+		},
+		{
+			name:    "empty code",
+			code:    "",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MinorUnit(tt.code)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MinorUnit() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil && !errors.Is(err, finerrors.ErrUnknownCurrency) {
+				t.Errorf("MinorUnit() error is not ErrUnknownCurrency: %v", err)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("MinorUnit() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsKnown(t *testing.T) {
+	if !IsKnown("USD") {
+		t.Errorf("IsKnown(USD) = false, want true")
+	}
+	if IsKnown("XYZ") {
+		t.Errorf("IsKnown(XYZ) = true, want false")
+	}
+}
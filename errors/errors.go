@@ -4,6 +4,7 @@ package errors
 import (
 	"errors"
 	"fmt"
+	"strings"
 )
 
 // Standard errors that can be returned by financial arithmetic operations.
@@ -26,6 +27,44 @@ var (
 
 	// ErrInvalidRounding is returned when an invalid rounding mode is specified.
 	ErrInvalidRounding = errors.New("invalid rounding mode")
+
+	// ErrPrecisionLoss is returned when a conversion cannot represent a value exactly enough.
+	ErrPrecisionLoss = errors.New("precision loss")
+
+	// ErrInputTooLong is returned when an input string exceeds the maximum accepted length.
+	ErrInputTooLong = errors.New("input too long")
+
+	// ErrUnknownCurrency is returned when a currency code is not recognized.
+	ErrUnknownCurrency = errors.New("unknown currency")
+
+	// ErrRateNotFound is returned when no exchange rate is available for a currency pair.
+	ErrRateNotFound = errors.New("exchange rate not found")
+
+	// ErrCurrencyMismatch is returned when an operation combines amounts in different currencies.
+	ErrCurrencyMismatch = errors.New("currency mismatch")
+
+	// ErrInvalidArgument is returned when an argument is outside the range an operation accepts.
+	ErrInvalidArgument = errors.New("invalid argument")
+
+	// ErrInvalidFormat is returned when an input string is not in a recognized format.
+	ErrInvalidFormat = errors.New("invalid format")
+
+	// ErrEmptyInput is returned when an operation requires at least one value but none were provided.
+	ErrEmptyInput = errors.New("empty input")
+
+	// ErrInvalidRange is returned when the lower bound of a range is greater than its upper bound.
+	ErrInvalidRange = errors.New("invalid range")
+
+	// ErrReversalExceedsOriginal is returned when a reversal or chargeback is larger than the
+	// transaction it reverses.
+	ErrReversalExceedsOriginal = errors.New("reversal exceeds original amount")
+
+	// ErrRateDeviation is returned when a value moves further from its reference than allowed.
+	ErrRateDeviation = errors.New("rate deviation exceeds limit")
+
+	// ErrScaleMismatch is returned when values and the total they must tie out to are expressed at
+	// different scales.
+	ErrScaleMismatch = errors.New("scale mismatch")
 )
 
 // OverflowError represents an arithmetic overflow with additional context.
@@ -78,3 +117,79 @@ func NewLimitError(value, limit interface{}, operation string) *LimitError {
 		Operation: operation,
 	}
 }
+
+// DeviationError represents a value moving further from its reference than a defined limit.
+type DeviationError struct {
+	Deviation interface{}
+	Limit     interface{}
+	Measure   string
+}
+
+// Error returns the error message for a DeviationError.
+func (e *DeviationError) Error() string {
+	return fmt.Sprintf("%s deviation of %v exceeds limit of %v", e.Measure, e.Deviation, e.Limit)
+}
+
+// Is implements the errors.Is interface.
+func (e *DeviationError) Is(target error) bool {
+	return target == ErrRateDeviation
+}
+
+// NewDeviationError creates a new DeviationError.
+func NewDeviationError(deviation, limit interface{}, measure string) *DeviationError {
+	return &DeviationError{
+		Deviation: deviation,
+		Limit:     limit,
+		Measure:   measure,
+	}
+}
+
+// InputTooLongError represents an input string that exceeds the maximum accepted length.
+type InputTooLongError struct {
+	Length int
+	Max    int
+}
+
+// Error returns the error message for an InputTooLongError.
+func (e *InputTooLongError) Error() string {
+	return fmt.Sprintf("input length %d exceeds maximum of %d", e.Length, e.Max)
+}
+
+// Is implements the errors.Is interface.
+func (e *InputTooLongError) Is(target error) bool {
+	return target == ErrInputTooLong
+}
+
+// NewInputTooLongError creates a new InputTooLongError.
+func NewInputTooLongError(length, max int) *InputTooLongError {
+	return &InputTooLongError{
+		Length: length,
+		Max:    max,
+	}
+}
+
+// MultiError collects several independent errors into a single error value.
+type MultiError struct {
+	Errors []error
+}
+
+// Error returns the error message for a MultiError.
+func (e *MultiError) Error() string {
+	msgs := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		msgs[i] = err.Error()
+	}
+	return fmt.Sprintf("%d errors occurred: %s", len(e.Errors), strings.Join(msgs, "; "))
+}
+
+// Unwrap returns the collected errors so errors.Is and errors.As can inspect each of them.
+func (e *MultiError) Unwrap() []error {
+	return e.Errors
+}
+
+// NewMultiError creates a new MultiError from the given errors.
+func NewMultiError(errs ...error) *MultiError {
+	return &MultiError{
+		Errors: errs,
+	}
+}
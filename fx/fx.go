@@ -0,0 +1,103 @@
+// Package fx provides foreign exchange conversions between currencies.
+package fx
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/nduyhai/finarith/currency"
+	"github.com/nduyhai/finarith/errors"
+	"github.com/nduyhai/finarith/safedec"
+)
+
+// Converter converts amounts between currencies using mid-market exchange rates.
+type Converter struct {
+	rates map[string]safedec.Decimal
+}
+
+// NewConverter creates a new Converter with no exchange rates.
+func NewConverter() *Converter {
+	return &Converter{
+		rates: make(map[string]safedec.Decimal),
+	}
+}
+
+// SetRate sets the mid-market rate for converting one unit of the from currency into the to currency.
+// Returns an error if either currency is not recognized or if the rate is not positive.
+func (c *Converter) SetRate(from, to string, rate safedec.Decimal) error {
+	if _, err := currency.MinorUnit(from); err != nil {
+		return err
+	}
+	if _, err := currency.MinorUnit(to); err != nil {
+		return err
+	}
+	if !rate.IsPositive() {
+		return errors.ErrNegativeValue
+	}
+
+	c.rates[pairKey(from, to)] = rate
+	return nil
+}
+
+// Rate returns the mid-market rate for converting one unit of the from currency into the to currency.
+// Converting a currency into itself always has a rate of 1, and when only the opposite direction has
+// been set its inverse is used.
+// Returns an error if no rate is available for the pair.
+func (c *Converter) Rate(from, to string) (safedec.Decimal, error) {
+	if strings.EqualFold(from, to) {
+		return safedec.One(), nil
+	}
+
+	if rate, ok := c.rates[pairKey(from, to)]; ok {
+		return rate, nil
+	}
+	if inverse, ok := c.rates[pairKey(to, from)]; ok {
+		return safedec.One().Div(inverse)
+	}
+
+	return safedec.Zero(), fmt.Errorf("%w: %s to %s", errors.ErrRateNotFound, from, to)
+}
+
+// Convert converts the amount from one currency into another at the mid-market rate.
+// Returns an error if no rate is available for the pair.
+func (c *Converter) Convert(amount safedec.Decimal, from, to string) (safedec.Decimal, error) {
+	if strings.EqualFold(from, to) {
+		return amount, nil
+	}
+
+	if rate, ok := c.rates[pairKey(from, to)]; ok {
+		return amount.Mul(rate), nil
+	}
+	// Dividing by the opposite rate avoids compounding the error of a truncated inverse
+	if inverse, ok := c.rates[pairKey(to, from)]; ok {
+		return amount.Div(inverse)
+	}
+
+	return safedec.Zero(), fmt.Errorf("%w: %s to %s", errors.ErrRateNotFound, from, to)
+}
+
+// ConvertWithSpread converts the amount from one currency into another at the mid-market rate
+// marked down by spreadBps basis points, so the converted amount is lower than at the mid rate.
+// Returns an error if the spread is negative or 10000 basis points or more, or if no rate is
+// available for the pair.
+func (c *Converter) ConvertWithSpread(amount safedec.Decimal, from, to string, spreadBps int64) (safedec.Decimal, error) {
+	if spreadBps < 0 {
+		return safedec.Zero(), errors.ErrNegativeValue
+	}
+	if spreadBps >= 10000 {
+		return safedec.Zero(), errors.NewLimitError(spreadBps, 10000, "spread basis points")
+	}
+
+	converted, err := c.Convert(amount, from, to)
+	if err != nil {
+		return safedec.Zero(), err
+	}
+
+	bps := safedec.NewFromInt(10000)
+	return converted.Mul(bps.Sub(safedec.NewFromInt(spreadBps))).Div(bps)
+}
+
+// pairKey returns the map key for a currency pair.
+func pairKey(from, to string) string {
+	return strings.ToUpper(from) + "/" + strings.ToUpper(to)
+}
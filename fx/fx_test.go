@@ -0,0 +1,194 @@
+package fx
+
+import (
+	"errors"
+	"testing"
+
+	finerrors "github.com/nduyhai/finarith/errors"
+	"github.com/nduyhai/finarith/safedec"
+)
+
+func newTestConverter(t *testing.T) *Converter {
+	t.Helper()
+
+	c := NewConverter()
+	rate, _ := safedec.NewFromString("0.92")
+	if err := c.SetRate("USD", "EUR", rate); err != nil {
+		t.Fatalf("SetRate() error = %v", err)
+	}
+	rate, _ = safedec.NewFromString("150")
+	if err := c.SetRate("USD", "JPY", rate); err != nil {
+		t.Fatalf("SetRate() error = %v", err)
+	}
+	return c
+}
+
+func TestConverter_SetRate(t *testing.T) {
+	tests := []struct {
+		name      string
+		from      string
+		to        string
+		rate      string
+		wantErr   bool
+		errorType error
+	}{
+		{
+			name: "valid rate",
+			from: "USD",
+			to:   "EUR",
+			rate: "0.92",
+		},
+		{
+			name:      "unknown currency",
+			from:      "USD",
+			to:        "XYZ",
+			rate:      "1.5",
+			wantErr:   true,
+			errorType: finerrors.ErrUnknownCurrency,
+		},
+		{
+			name:      "zero rate",
+			from:      "USD",
+			to:        "EUR",
+			rate:      "0",
+			wantErr:   true,
+			errorType: finerrors.ErrNegativeValue,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rate, _ := safedec.NewFromString(tt.rate)
+			err := NewConverter().SetRate(tt.from, tt.to, rate)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SetRate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil && !errors.Is(err, tt.errorType) {
+				t.Errorf("SetRate() error type = %v, want %v", err, tt.errorType)
+			}
+		})
+	}
+}
+
+func TestConverter_Convert(t *testing.T) {
+	tests := []struct {
+		name    string
+		amount  string
+		from    string
+		to      string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:   "direct rate",
+			amount: "100",
+			from:   "USD",
+			to:     "EUR",
+			want:   "92",
+		},
+		{
+			name:   "inverse rate",
+			amount: "1500",
+			from:   "JPY",
+			to:     "USD",
+			want:   "10",
+		},
+		{
+			name:   "same currency",
+			amount: "42.5",
+			from:   "EUR",
+			to:     "eur",
+			want:   "42.5",
+		},
+		{
+			name:    "missing rate",
+			amount:  "100",
+			from:    "EUR",
+			to:      "JPY",
+			wantErr: true,
+		},
+	}
+
+	c := newTestConverter(t)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			amount, _ := safedec.NewFromString(tt.amount)
+			got, err := c.Convert(amount, tt.from, tt.to)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Convert() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil && !errors.Is(err, finerrors.ErrRateNotFound) {
+				t.Errorf("Convert() error type = %v, want %v", err, finerrors.ErrRateNotFound)
+			}
+			if !tt.wantErr && got.String() != tt.want {
+				t.Errorf("Convert() = %v, want %v", got.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestConverter_ConvertWithSpread(t *testing.T) {
+	tests := []struct {
+		name      string
+		amount    string
+		spreadBps int64
+		want      string
+		wantErr   bool
+		errorType error
+	}{
+		{
+			name:      "no spread matches mid rate",
+			amount:    "100",
+			spreadBps: 0,
+			want:      "92",
+		},
+		{
+			name:      "50 basis point spread",
+			amount:    "100",
+			spreadBps: 50,
+			want:      "91.54",
+		},
+		{
+			name:      "negative spread",
+			amount:    "100",
+			spreadBps: -1,
+			wantErr:   true,
+			errorType: finerrors.ErrNegativeValue,
+		},
+		{
+			name:      "spread of 100 percent",
+			amount:    "100",
+			spreadBps: 10000,
+			wantErr:   true,
+			errorType: finerrors.ErrExceedsLimit,
+		},
+	}
+
+	c := newTestConverter(t)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			amount, _ := safedec.NewFromString(tt.amount)
+			got, err := c.ConvertWithSpread(amount, "USD", "EUR", tt.spreadBps)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ConvertWithSpread() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				if !errors.Is(err, tt.errorType) {
+					t.Errorf("ConvertWithSpread() error type = %v, want %v", err, tt.errorType)
+				}
+				return
+			}
+			if got.String() != tt.want {
+				t.Errorf("ConvertWithSpread() = %v, want %v", got.String(), tt.want)
+			}
+
+			mid, _ := c.Convert(amount, "USD", "EUR")
+			if got.GreaterThan(mid) {
+				t.Errorf("ConvertWithSpread() = %v, want at most the mid-rate conversion %v", got.String(), mid.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,161 @@
+// Package money provides a currency-aware monetary amount built on safedec.Decimal.
+package money
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/nduyhai/finarith/currency"
+	"github.com/nduyhai/finarith/errors"
+	"github.com/nduyhai/finarith/rounding"
+	"github.com/nduyhai/finarith/safedec"
+)
+
+// Money represents a monetary amount in a specific ISO 4217 currency.
+type Money struct {
+	amount   safedec.Decimal
+	currency string
+}
+
+// New creates a new Money from an amount and an ISO 4217 currency code.
+// The code is matched case-insensitively and stored in upper case.
+// Returns an error if the currency is not recognized.
+func New(amount safedec.Decimal, code string) (Money, error) {
+	if _, err := currency.MinorUnit(code); err != nil {
+		return Money{}, err
+	}
+	return Money{amount: amount, currency: strings.ToUpper(code)}, nil
+}
+
+// NewFromString creates a new Money from a string amount and an ISO 4217 currency code.
+// Returns an error if the amount cannot be parsed or if the currency is not recognized.
+func NewFromString(amount, code string) (Money, error) {
+	d, err := safedec.NewFromString(amount)
+	if err != nil {
+		return Money{}, err
+	}
+	return New(d, code)
+}
+
+// Amount returns the amount of the money value.
+func (m Money) Amount() safedec.Decimal {
+	return m.amount
+}
+
+// Currency returns the ISO 4217 currency code of the money value.
+func (m Money) Currency() string {
+	return m.currency
+}
+
+// String returns the string representation of the money value, e.g. "12.5 USD".
+func (m Money) String() string {
+	return m.amount.String() + " " + m.currency
+}
+
+// Negate returns the negation of the money value in the same currency.
+func (m Money) Negate() Money {
+	return Money{amount: m.amount.Neg(), currency: m.currency}
+}
+
+// Abs returns the absolute value of the money value in the same currency.
+func (m Money) Abs() Money {
+	return Money{amount: m.amount.Abs(), currency: m.currency}
+}
+
+// IsNegative returns true if the amount is negative.
+func (m Money) IsNegative() bool {
+	return m.amount.IsNegative()
+}
+
+// IsPositive returns true if the amount is positive.
+func (m Money) IsPositive() bool {
+	return m.amount.IsPositive()
+}
+
+// IsZero returns true if the amount is zero.
+func (m Money) IsZero() bool {
+	return m.amount.IsZero()
+}
+
+// Add adds the other money value to this one and returns a new Money.
+// Returns an error wrapping ErrCurrencyMismatch if the currencies differ.
+func (m Money) Add(other Money) (Money, error) {
+	if err := m.checkCurrency(other); err != nil {
+		return Money{}, err
+	}
+	return Money{amount: m.amount.Add(other.amount), currency: m.currency}, nil
+}
+
+// Sub subtracts the other money value from this one and returns a new Money.
+// Returns an error wrapping ErrCurrencyMismatch if the currencies differ.
+func (m Money) Sub(other Money) (Money, error) {
+	if err := m.checkCurrency(other); err != nil {
+		return Money{}, err
+	}
+	return Money{amount: m.amount.Sub(other.amount), currency: m.currency}, nil
+}
+
+// Mul multiplies the money value by a scalar and returns a new Money in the same currency.
+func (m Money) Mul(factor safedec.Decimal) Money {
+	return Money{amount: m.amount.Mul(factor), currency: m.currency}
+}
+
+// Div divides the money value by a scalar and returns a new Money in the same currency.
+// Returns an error if the divisor is zero.
+func (m Money) Div(divisor safedec.Decimal) (Money, error) {
+	amount, err := m.amount.Div(divisor)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{amount: amount, currency: m.currency}, nil
+}
+
+// DivScalar divides the money value by a scalar, rounds the result to the specified number of
+// decimal places using the specified rounding mode, and returns a new Money in the same currency.
+// Returns an error if the divisor is zero or if the rounding mode is invalid.
+func (m Money) DivScalar(divisor safedec.Decimal, places int32, mode rounding.Mode) (Money, error) {
+	amount, err := m.amount.DivRound(divisor, places, mode)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{amount: amount, currency: m.currency}, nil
+}
+
+// Ratio returns the dimensionless ratio of this money value to the other.
+// Returns an error wrapping ErrCurrencyMismatch if the currencies differ, or ErrDivideByZero if
+// the other amount is zero.
+func (m Money) Ratio(other Money) (safedec.Decimal, error) {
+	if err := m.checkCurrency(other); err != nil {
+		return safedec.Decimal{}, err
+	}
+	return m.amount.Div(other.amount)
+}
+
+// SumMoney adds the items and returns their exact total in their common currency, at the largest
+// number of decimal places among them, leaving any rounding to the caller so that the total is
+// rounded once rather than per item.
+// Returns ErrEmptyInput if items is empty, or an error wrapping ErrCurrencyMismatch if the items
+// are not all in the same currency.
+func SumMoney(items []Money) (Money, error) {
+	if len(items) == 0 {
+		return Money{}, errors.ErrEmptyInput
+	}
+
+	total := items[0]
+	for _, item := range items[1:] {
+		var err error
+		total, err = total.Add(item)
+		if err != nil {
+			return Money{}, err
+		}
+	}
+	return total, nil
+}
+
+// checkCurrency returns an error wrapping ErrCurrencyMismatch if other is in a different currency.
+func (m Money) checkCurrency(other Money) error {
+	if m.currency != other.currency {
+		return fmt.Errorf("%w: %s and %s", errors.ErrCurrencyMismatch, m.currency, other.currency)
+	}
+	return nil
+}
@@ -0,0 +1,510 @@
+package money
+
+import (
+	"errors"
+	"testing"
+
+	finerrors "github.com/nduyhai/finarith/errors"
+	"github.com/nduyhai/finarith/rounding"
+	"github.com/nduyhai/finarith/safedec"
+)
+
+func mustNew(t *testing.T, amount, code string) Money {
+	t.Helper()
+
+	d, err := safedec.NewFromString(amount)
+	if err != nil {
+		t.Fatalf("safedec.NewFromString(%q) error = %v", amount, err)
+	}
+	m, err := New(d, code)
+	if err != nil {
+		t.Fatalf("New(%q, %q) error = %v", amount, code, err)
+	}
+	return m
+}
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name         string
+		amount       string
+		code         string
+		wantCurrency string
+		wantErr      bool
+	}{
+		{
+			name:         "known currency",
+			amount:       "10.50",
+			code:         "USD",
+			wantCurrency: "USD",
+		},
+		{
+			name:         "lower case code",
+			amount:       "10.50",
+			code:         "eur",
+			wantCurrency: "EUR",
+		},
+		{
+			name:    "unknown currency",
+			amount:  "10.50",
+			code:    "XYZ",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			amount, _ := safedec.NewFromString(tt.amount)
+
+			got, err := New(amount, tt.code)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("New() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				if !errors.Is(err, finerrors.ErrUnknownCurrency) {
+					t.Errorf("New() error type = %v, want %v", err, finerrors.ErrUnknownCurrency)
+				}
+				return
+			}
+			if !got.Amount().Equal(amount) {
+				t.Errorf("New() Amount = %v, want %v", got.Amount(), amount)
+			}
+			if got.Currency() != tt.wantCurrency {
+				t.Errorf("New() Currency = %v, want %v", got.Currency(), tt.wantCurrency)
+			}
+		})
+	}
+}
+
+func TestNewFromString(t *testing.T) {
+	tests := []struct {
+		name       string
+		amount     string
+		code       string
+		wantAmount string
+		wantErr    bool
+		errorType  error
+	}{
+		{
+			name:       "valid amount",
+			amount:     "1234.56",
+			code:       "usd",
+			wantAmount: "1234.56",
+		},
+		{
+			name:    "invalid amount",
+			amount:  "12.34.56",
+			code:    "USD",
+			wantErr: true,
+		},
+		{
+			name:      "unknown currency",
+			amount:    "10",
+			code:      "ABC",
+			wantErr:   true,
+			errorType: finerrors.ErrUnknownCurrency,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewFromString(tt.amount, tt.code)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewFromString() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				if tt.errorType != nil && !errors.Is(err, tt.errorType) {
+					t.Errorf("NewFromString() error type = %v, want %v", err, tt.errorType)
+				}
+				return
+			}
+			if got.Amount().String() != tt.wantAmount {
+				t.Errorf("NewFromString() Amount = %v, want %v", got.Amount(), tt.wantAmount)
+			}
+			if got.Currency() != "USD" {
+				t.Errorf("NewFromString() Currency = %v, want USD", got.Currency())
+			}
+		})
+	}
+}
+
+func TestMoney_String(t *testing.T) {
+	m := mustNew(t, "12.50", "USD")
+	if got := m.String(); got != "12.5 USD" {
+		t.Errorf("String() = %v, want 12.5 USD", got)
+	}
+}
+
+func TestMoney_Negate(t *testing.T) {
+	m := mustNew(t, "25.00", "USD")
+
+	got := m.Negate()
+	if got.Amount().String() != "-25" {
+		t.Errorf("Negate() Amount = %v, want -25", got.Amount())
+	}
+	if got.Currency() != "USD" {
+		t.Errorf("Negate() Currency = %v, want USD", got.Currency())
+	}
+	if !got.IsNegative() || got.IsPositive() || got.IsZero() {
+		t.Errorf("Negate() sign helpers = negative %v, positive %v, zero %v", got.IsNegative(), got.IsPositive(), got.IsZero())
+	}
+
+	back := got.Negate()
+	if !back.Amount().Equal(m.Amount()) || back.IsNegative() || !back.IsPositive() {
+		t.Errorf("Negate() twice = %v, want %v", back, m)
+	}
+}
+
+func TestMoney_Abs(t *testing.T) {
+	tests := []struct {
+		name   string
+		amount string
+		want   string
+	}{
+		{
+			name:   "negative amount",
+			amount: "-42.10",
+			want:   "42.1",
+		},
+		{
+			name:   "positive amount",
+			amount: "42.10",
+			want:   "42.1",
+		},
+		{
+			name:   "zero",
+			amount: "0",
+			want:   "0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := mustNew(t, tt.amount, "EUR")
+
+			got := m.Abs()
+			if got.Amount().String() != tt.want {
+				t.Errorf("Abs() Amount = %v, want %v", got.Amount(), tt.want)
+			}
+			if got.Currency() != "EUR" {
+				t.Errorf("Abs() Currency = %v, want EUR", got.Currency())
+			}
+			if got.IsNegative() {
+				t.Errorf("Abs() IsNegative = true, want false")
+			}
+		})
+	}
+}
+
+func TestMoney_IsZero(t *testing.T) {
+	if !mustNew(t, "0.00", "USD").IsZero() {
+		t.Errorf("IsZero() = false, want true")
+	}
+	if mustNew(t, "0.01", "USD").IsZero() {
+		t.Errorf("IsZero() = true, want false")
+	}
+}
+
+func TestMoney_AddSub(t *testing.T) {
+	tests := []struct {
+		name    string
+		a       Money
+		b       Money
+		wantAdd string
+		wantSub string
+		wantErr bool
+	}{
+		{
+			name:    "same currency",
+			a:       mustNew(t, "10.25", "USD"),
+			b:       mustNew(t, "4.75", "USD"),
+			wantAdd: "15 USD",
+			wantSub: "5.5 USD",
+		},
+		{
+			name:    "negative result",
+			a:       mustNew(t, "1", "JPY"),
+			b:       mustNew(t, "3", "jpy"),
+			wantAdd: "4 JPY",
+			wantSub: "-2 JPY",
+		},
+		{
+			name:    "currency mismatch",
+			a:       mustNew(t, "10", "USD"),
+			b:       mustNew(t, "10", "EUR"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sum, err := tt.a.Add(tt.b)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Add() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil && !errors.Is(err, finerrors.ErrCurrencyMismatch) {
+				t.Errorf("Add() error type = %v, want %v", err, finerrors.ErrCurrencyMismatch)
+			}
+			if !tt.wantErr && sum.String() != tt.wantAdd {
+				t.Errorf("Add() = %v, want %v", sum, tt.wantAdd)
+			}
+
+			diff, err := tt.a.Sub(tt.b)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Sub() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil && !errors.Is(err, finerrors.ErrCurrencyMismatch) {
+				t.Errorf("Sub() error type = %v, want %v", err, finerrors.ErrCurrencyMismatch)
+			}
+			if !tt.wantErr && diff.String() != tt.wantSub {
+				t.Errorf("Sub() = %v, want %v", diff, tt.wantSub)
+			}
+		})
+	}
+}
+
+func TestMoney_Mul(t *testing.T) {
+	m := mustNew(t, "19.99", "EUR")
+	factor, _ := safedec.NewFromString("3")
+
+	got := m.Mul(factor)
+	if got.String() != "59.97 EUR" {
+		t.Errorf("Mul() = %v, want 59.97 EUR", got)
+	}
+}
+
+func TestMoney_Div(t *testing.T) {
+	tests := []struct {
+		name    string
+		divisor string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "exact division",
+			divisor: "4",
+			want:    "25 GBP",
+		},
+		{
+			name:    "fractional divisor",
+			divisor: "0.5",
+			want:    "200 GBP",
+		},
+		{
+			name:    "zero divisor",
+			divisor: "0",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := mustNew(t, "100", "GBP")
+			divisor, _ := safedec.NewFromString(tt.divisor)
+
+			got, err := m.Div(divisor)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Div() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				if !errors.Is(err, finerrors.ErrDivideByZero) {
+					t.Errorf("Div() error type = %v, want %v", err, finerrors.ErrDivideByZero)
+				}
+				return
+			}
+			if got.String() != tt.want {
+				t.Errorf("Div() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMoney_DivScalar(t *testing.T) {
+	tests := []struct {
+		name      string
+		amount    string
+		divisor   string
+		mode      rounding.Mode
+		want      string
+		wantErr   bool
+		errorType error
+	}{
+		{
+			name:    "100 dollars by 3 half up",
+			amount:  "100",
+			divisor: "3",
+			mode:    rounding.RoundHalfUp,
+			want:    "33.33 USD",
+		},
+		{
+			name:    "100 dollars by 3 round up",
+			amount:  "100",
+			divisor: "3",
+			mode:    rounding.RoundUp,
+			want:    "33.34 USD",
+		},
+		{
+			name:      "zero divisor",
+			amount:    "100",
+			divisor:   "0",
+			mode:      rounding.RoundHalfUp,
+			wantErr:   true,
+			errorType: finerrors.ErrDivideByZero,
+		},
+		{
+			name:      "invalid rounding mode",
+			amount:    "100",
+			divisor:   "3",
+			mode:      rounding.Mode(99),
+			wantErr:   true,
+			errorType: finerrors.ErrInvalidRounding,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := mustNew(t, tt.amount, "USD")
+			divisor, _ := safedec.NewFromString(tt.divisor)
+
+			got, err := m.DivScalar(divisor, 2, tt.mode)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("DivScalar() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				if !errors.Is(err, tt.errorType) {
+					t.Errorf("DivScalar() error type = %v, want %v", err, tt.errorType)
+				}
+				return
+			}
+			if got.String() != tt.want {
+				t.Errorf("DivScalar() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMoney_Ratio(t *testing.T) {
+	tests := []struct {
+		name      string
+		a         Money
+		b         Money
+		want      string
+		wantErr   bool
+		errorType error
+	}{
+		{
+			name: "same currency",
+			a:    mustNew(t, "25.00", "USD"),
+			b:    mustNew(t, "100.00", "USD"),
+			want: "0.25",
+		},
+		{
+			name: "ratio above one",
+			a:    mustNew(t, "150", "EUR"),
+			b:    mustNew(t, "100", "EUR"),
+			want: "1.5",
+		},
+		{
+			name:      "currency mismatch",
+			a:         mustNew(t, "25", "USD"),
+			b:         mustNew(t, "100", "EUR"),
+			wantErr:   true,
+			errorType: finerrors.ErrCurrencyMismatch,
+		},
+		{
+			name:      "zero divisor",
+			a:         mustNew(t, "25", "USD"),
+			b:         mustNew(t, "0", "USD"),
+			wantErr:   true,
+			errorType: finerrors.ErrDivideByZero,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.a.Ratio(tt.b)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Ratio() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				if !errors.Is(err, tt.errorType) {
+					t.Errorf("Ratio() error type = %v, want %v", err, tt.errorType)
+				}
+				return
+			}
+			if got.String() != tt.want {
+				t.Errorf("Ratio() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSumMoney(t *testing.T) {
+	tests := []struct {
+		name       string
+		items      []Money
+		want       string
+		wantPlaces int32
+		wantErr    bool
+		errorType  error
+	}{
+		{
+			name:       "same currency",
+			items:      []Money{mustNew(t, "19.99", "USD"), mustNew(t, "5.01", "USD"), mustNew(t, "0.10", "USD")},
+			want:       "25.1 USD",
+			wantPlaces: 2,
+		},
+		{
+			name:       "exact at the largest scale",
+			items:      []Money{mustNew(t, "10.00", "EUR"), mustNew(t, "0.3333", "EUR"), mustNew(t, "1", "EUR")},
+			want:       "11.3333 EUR",
+			wantPlaces: 4,
+		},
+		{
+			name:       "single item",
+			items:      []Money{mustNew(t, "500", "JPY")},
+			want:       "500 JPY",
+			wantPlaces: 0,
+		},
+		{
+			name:      "mixed currencies",
+			items:     []Money{mustNew(t, "10.00", "USD"), mustNew(t, "10.00", "EUR")},
+			wantErr:   true,
+			errorType: finerrors.ErrCurrencyMismatch,
+		},
+		{
+			name:      "empty",
+			items:     nil,
+			wantErr:   true,
+			errorType: finerrors.ErrEmptyInput,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SumMoney(tt.items)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SumMoney() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				if !errors.Is(err, tt.errorType) {
+					t.Errorf("SumMoney() error type = %v, want %v", err, tt.errorType)
+				}
+				return
+			}
+			if got.String() != tt.want {
+				t.Errorf("SumMoney() = %v, want %v", got, tt.want)
+			}
+			if got.Amount().DecimalPlaces() != tt.wantPlaces {
+				t.Errorf("SumMoney() decimal places = %v, want %v", got.Amount().DecimalPlaces(), tt.wantPlaces)
+			}
+		})
+	}
+}
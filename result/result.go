@@ -0,0 +1,74 @@
+// Package result provides a generic value-or-error type as an alternative to tuple returns.
+package result
+
+import "fmt"
+
+// Result holds either a value or the error that prevented it from being computed.
+// The zero value holds the zero value of T and no error.
+type Result[T any] struct {
+	value T
+	err   error
+}
+
+// New creates a Result from a value and an error, as returned by most functions in this module,
+// so that result.New(safedec.NewFromString("1.23")) wraps the call directly.
+// The value is discarded if err is not nil.
+func New[T any](value T, err error) Result[T] {
+	if err != nil {
+		return Err[T](err)
+	}
+	return Ok(value)
+}
+
+// Ok creates a successful Result holding the value.
+func Ok[T any](value T) Result[T] {
+	return Result[T]{value: value}
+}
+
+// Err creates a failed Result holding the error.
+func Err[T any](err error) Result[T] {
+	return Result[T]{err: err}
+}
+
+// Get returns the value and the error held by the Result.
+func (r Result[T]) Get() (T, error) {
+	return r.value, r.err
+}
+
+// Err returns the error held by the Result, or nil if it holds a value.
+func (r Result[T]) Err() error {
+	return r.err
+}
+
+// IsOk reports whether the Result holds a value rather than an error.
+func (r Result[T]) IsOk() bool {
+	return r.err == nil
+}
+
+// Must returns the value held by the Result.
+// It panics if the Result holds an error, so it is intended for constants and tests where the
+// input is known to be valid.
+func (r Result[T]) Must() T {
+	if r.err != nil {
+		panic(fmt.Sprintf("result: Must called on an error: %v", r.err))
+	}
+	return r.value
+}
+
+// Or returns the value held by the Result, or def if it holds an error.
+func (r Result[T]) Or(def T) T {
+	if r.err != nil {
+		return def
+	}
+	return r.value
+}
+
+// Then applies f to the value held by the Result and returns its outcome as a new Result.
+// If the Result holds an error, f is not called and the error is passed through, so a chain of
+// Then calls stops at the first error.
+func (r Result[T]) Then(f func(T) (T, error)) Result[T] {
+	if r.err != nil {
+		return r
+	}
+	return New(f(r.value))
+}
@@ -0,0 +1,156 @@
+package result
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	finerrors "github.com/nduyhai/finarith/errors"
+)
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   int
+		err     error
+		want    int
+		wantErr error
+	}{
+		{
+			name:  "value",
+			value: 42,
+			want:  42,
+		},
+		{
+			name:    "error discards the value",
+			value:   42,
+			err:     finerrors.ErrOverflow,
+			want:    0,
+			wantErr: finerrors.ErrOverflow,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := New(tt.value, tt.err)
+			got, err := r.Get()
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Get() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Get() = %v, want %v", got, tt.want)
+			}
+			if r.IsOk() != (tt.wantErr == nil) {
+				t.Errorf("IsOk() = %v, want %v", r.IsOk(), tt.wantErr == nil)
+			}
+		})
+	}
+}
+
+func TestResult_Must(t *testing.T) {
+	if got := Ok(7).Must(); got != 7 {
+		t.Errorf("Must() = %v, want 7", got)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Must() on an error did not panic")
+		}
+	}()
+	Err[int](finerrors.ErrInvalidFormat).Must()
+}
+
+func TestResult_Or(t *testing.T) {
+	tests := []struct {
+		name string
+		r    Result[int]
+		def  int
+		want int
+	}{
+		{
+			name: "value",
+			r:    Ok(7),
+			def:  -1,
+			want: 7,
+		},
+		{
+			name: "error",
+			r:    Err[int](finerrors.ErrInvalidFormat),
+			def:  -1,
+			want: -1,
+		},
+		{
+			name: "zero value",
+			r:    Result[int]{},
+			def:  -1,
+			want: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.r.Or(tt.def); got != tt.want {
+				t.Errorf("Or() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResult_Then(t *testing.T) {
+	double := func(v int) (int, error) { return v * 2, nil }
+	positive := func(v int) (int, error) {
+		if v <= 0 {
+			return 0, finerrors.ErrInvalidArgument
+		}
+		return v, nil
+	}
+
+	tests := []struct {
+		name    string
+		input   string
+		want    int
+		wantErr bool
+	}{
+		{
+			name:  "every step succeeds",
+			input: "21",
+			want:  84,
+		},
+		{
+			name:    "first step fails",
+			input:   "abc",
+			wantErr: true,
+		},
+		{
+			name:    "middle step fails",
+			input:   "-3",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			counted := func(v int) (int, error) {
+				calls++
+				return double(v)
+			}
+
+			r := New(strconv.Atoi(tt.input)).Then(positive).Then(double).Then(counted)
+			got, err := r.Get()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Then() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				if calls != 0 {
+					t.Errorf("Then() called a step after an error")
+				}
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Then() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -2,7 +2,11 @@
 package rounding
 
 import (
+	"fmt"
 	"math"
+	"strings"
+
+	"github.com/shopspring/decimal"
 
 	"github.com/nduyhai/finarith/errors"
 )
@@ -58,6 +62,38 @@ func (m Mode) String() string {
 	}
 }
 
+// ParseMode returns the rounding mode whose String representation matches s, ignoring case,
+// so that a mode written out with String can be read back without loss.
+// Returns an error wrapping ErrInvalidRounding if s does not name a rounding mode.
+func ParseMode(s string) (Mode, error) {
+	for m := RoundDown; m <= RoundFloor; m++ {
+		if strings.EqualFold(s, m.String()) {
+			return m, nil
+		}
+	}
+	return 0, fmt.Errorf("%w: %q", errors.ErrInvalidRounding, s)
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding the rounding mode as its String
+// representation so that it reads naturally in JSON, YAML, or TOML configuration.
+// Returns an error wrapping ErrInvalidRounding for an unknown mode, which could not be parsed back.
+func (m Mode) MarshalText() ([]byte, error) {
+	if m < RoundDown || m > RoundFloor {
+		return nil, fmt.Errorf("%w: %d", errors.ErrInvalidRounding, int(m))
+	}
+	return []byte(m.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, decoding a rounding mode with ParseMode.
+func (m *Mode) UnmarshalText(text []byte) error {
+	mode, err := ParseMode(string(text))
+	if err != nil {
+		return err
+	}
+	*m = mode
+	return nil
+}
+
 // RoundFloat64 rounds a float64 value to the specified number of decimal places
 // using the specified rounding mode.
 func RoundFloat64(value float64, decimals int, mode Mode) (float64, error) {
@@ -132,6 +168,52 @@ func RoundFloat64(value float64, decimals int, mode Mode) (float64, error) {
 	}
 }
 
+// RoundDecimalString rounds a decimal string to the specified number of decimal places using the
+// specified rounding mode and returns the result with exactly that many decimal places (e.g.
+// "2.675" at two places with RoundHalfUp is "2.68"). Unlike RoundFloat64, the value is parsed and
+// rounded as an exact decimal, so no precision is lost to binary floating point.
+// Returns the parse error if value is not a valid decimal, ErrInvalidPrecision if places is
+// negative, or ErrInvalidRounding if the rounding mode is invalid.
+func RoundDecimalString(value string, places int32, mode Mode) (string, error) {
+	if places < 0 {
+		return "", errors.ErrInvalidPrecision
+	}
+
+	d, err := decimal.NewFromString(value)
+	if err != nil {
+		return "", err
+	}
+
+	var result decimal.Decimal
+	switch mode {
+	case RoundDown:
+		result = d.RoundDown(places)
+	case RoundUp:
+		result = d.RoundUp(places)
+	case RoundHalfUp:
+		result = d.Round(places)
+	case RoundHalfDown:
+		// A tie lies exactly half a unit from the truncated value and goes toward zero
+		truncated := d.Truncate(places)
+		halfUnit := decimal.New(5, -places-1)
+		if d.Sub(truncated).Abs().Equal(halfUnit) {
+			result = truncated
+		} else {
+			result = d.Round(places)
+		}
+	case RoundHalfEven:
+		result = d.RoundBank(places)
+	case RoundCeiling:
+		result = d.RoundCeil(places)
+	case RoundFloor:
+		result = d.RoundFloor(places)
+	default:
+		return "", errors.ErrInvalidRounding
+	}
+
+	return result.StringFixed(places), nil
+}
+
 // RoundInt64 rounds an int64 value to the nearest multiple of the specified unit
 // using the specified rounding mode.
 func RoundInt64(value, unit int64, mode Mode) (int64, error) {
@@ -223,3 +305,116 @@ func RoundInt64(value, unit int64, mode Mode) (int64, error) {
 		return 0, errors.ErrInvalidRounding
 	}
 }
+
+// maxPow2 is the largest power of two representable as an int64.
+const maxPow2 int64 = 1 << 62
+
+// RoundToPow2 rounds a positive int64 value to a power of two using the specified rounding mode.
+// RoundDown and RoundFloor select the largest power of two not above value, and RoundUp and
+// RoundCeiling the smallest power of two not below it. The round-to-nearest modes select the
+// closer of the two; at the midpoint (e.g. 3, 6 or 12), RoundHalfUp selects the larger,
+// RoundHalfDown the smaller, and RoundHalfEven the one with an even exponent (4 for 3 and 6,
+// 16 for 12).
+// Returns ErrInvalidArgument if value is not positive, or ErrOverflow if rounding up would exceed
+// the largest power of two representable as an int64.
+func RoundToPow2(value int64, mode Mode) (int64, error) {
+	if value <= 0 {
+		return 0, errors.ErrInvalidArgument
+	}
+
+	// Find the largest power of two not above value
+	lower, exponent := int64(1), 0
+	for lower <= value/2 {
+		lower *= 2
+		exponent++
+	}
+
+	// Compare the distance to lower with the distance to 2*lower without halving lower, which
+	// truncates for lower == 1; the doubled distance cannot overflow since value < 2*lower
+	toMidpoint := 2*(value-lower) - lower
+
+	var up bool
+	switch mode {
+	case RoundDown, RoundFloor:
+		up = false
+	case RoundUp, RoundCeiling:
+		up = true
+	case RoundHalfUp:
+		up = toMidpoint >= 0
+	case RoundHalfDown:
+		up = toMidpoint > 0
+	case RoundHalfEven:
+		up = toMidpoint > 0 || (toMidpoint == 0 && exponent%2 == 1)
+	default:
+		return 0, errors.ErrInvalidRounding
+	}
+
+	// A power of two is returned unchanged by every mode
+	if !up || value == lower {
+		return lower, nil
+	}
+	if lower == maxPow2 {
+		return 0, errors.ErrOverflow
+	}
+	return lower * 2, nil
+}
+
+// RoundInt64ToIncrement rounds an int64 value to a multiple of increment using the specified
+// rounding mode, with the mode applied to the number of increments. It is the integer
+// counterpart of safedec.RoundToIncrement for amounts in minor units, such as rounding cash
+// amounts in centimes or öre to the nearest 5 (e.g. 1238 is 1240 with RoundHalfUp).
+// Negative values round symmetrically to positive ones, so -1238 is -1240 with RoundHalfUp.
+// Returns ErrInvalidArgument if the increment is not positive, ErrInvalidRounding if the rounding
+// mode is invalid, or ErrOverflow if the rounded value cannot be represented as an int64.
+func RoundInt64ToIncrement(value, increment int64, mode Mode) (int64, error) {
+	if increment <= 0 {
+		return 0, errors.ErrInvalidArgument
+	}
+
+	// Split the value into whole increments, truncated toward zero, and a remainder with the
+	// sign of the value
+	quotient, remainder := value/increment, value%increment
+	absRemainder := remainder
+	if absRemainder < 0 {
+		absRemainder = -absRemainder
+	}
+
+	// Compare the remainder with its distance to the next increment to locate the midpoint,
+	// which avoids doubling a remainder that may be close to the int64 limits
+	toNext := increment - absRemainder
+
+	var away bool
+	switch mode {
+	case RoundDown:
+		away = false
+	case RoundUp:
+		away = true
+	case RoundCeiling:
+		away = value > 0
+	case RoundFloor:
+		away = value < 0
+	case RoundHalfUp:
+		away = absRemainder >= toNext
+	case RoundHalfDown:
+		away = absRemainder > toNext
+	case RoundHalfEven:
+		away = absRemainder > toNext || (absRemainder == toNext && quotient%2 != 0)
+	default:
+		return 0, errors.ErrInvalidRounding
+	}
+
+	if !away || remainder == 0 {
+		return quotient * increment, nil
+	}
+
+	if value > 0 {
+		if quotient+1 > math.MaxInt64/increment {
+			return 0, errors.ErrOverflow
+		}
+		return (quotient + 1) * increment, nil
+	}
+	if quotient-1 < math.MinInt64/increment {
+		return 0, errors.ErrOverflow
+	}
+	return (quotient - 1) * increment, nil
+}
@@ -1,6 +1,7 @@
 package rounding
 
 import (
+	"encoding/json"
 	"errors"
 	"math"
 	"testing"
@@ -65,6 +66,207 @@ func TestMode_String(t *testing.T) {
 	}
 }
 
+func TestParseMode(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    Mode
+		wantErr bool
+	}{
+		{
+			name:  "round_half_even",
+			input: "round_half_even",
+			want:  RoundHalfEven,
+		},
+		{
+			name:  "upper case",
+			input: "ROUND_HALF_UP",
+			want:  RoundHalfUp,
+		},
+		{
+			name:  "mixed case",
+			input: "Round_Floor",
+			want:  RoundFloor,
+		},
+		{
+			name:    "unknown name",
+			input:   "round_nearest",
+			wantErr: true,
+		},
+		{
+			name:    "String of an invalid mode",
+			input:   "unknown",
+			wantErr: true,
+		},
+		{
+			name:    "empty",
+			input:   "",
+			wantErr: true,
+		},
+		{
+			name:    "surrounding whitespace",
+			input:   " round_up ",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseMode(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseMode() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				if !errors.Is(err, finerrors.ErrInvalidRounding) {
+					t.Errorf("ParseMode() error = %v, want %v", err, finerrors.ErrInvalidRounding)
+				}
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseMode() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseMode_RoundTrip(t *testing.T) {
+	for m := RoundDown; m <= RoundFloor; m++ {
+		got, err := ParseMode(m.String())
+		if err != nil {
+			t.Errorf("ParseMode(%q) error = %v", m.String(), err)
+			continue
+		}
+		if got != m {
+			t.Errorf("ParseMode(%q) = %v, want %v", m.String(), got, m)
+		}
+	}
+}
+
+func TestMode_MarshalText(t *testing.T) {
+	tests := []struct {
+		name    string
+		mode    Mode
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "RoundHalfEven",
+			mode: RoundHalfEven,
+			want: "round_half_even",
+		},
+		{
+			name: "RoundDown",
+			mode: RoundDown,
+			want: "round_down",
+		},
+		{
+			name:    "unknown mode",
+			mode:    Mode(99),
+			wantErr: true,
+		},
+		{
+			name:    "negative mode",
+			mode:    Mode(-1),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.mode.MarshalText()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MarshalText() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				if !errors.Is(err, finerrors.ErrInvalidRounding) {
+					t.Errorf("MarshalText() error = %v, want %v", err, finerrors.ErrInvalidRounding)
+				}
+				return
+			}
+			if string(got) != tt.want {
+				t.Errorf("MarshalText() = %v, want %v", string(got), tt.want)
+			}
+		})
+	}
+}
+
+func TestMode_UnmarshalText(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    Mode
+		wantErr bool
+	}{
+		{
+			name:  "round_ceiling",
+			input: "round_ceiling",
+			want:  RoundCeiling,
+		},
+		{
+			name:  "upper case",
+			input: "ROUND_HALF_DOWN",
+			want:  RoundHalfDown,
+		},
+		{
+			name:    "unknown",
+			input:   "unknown",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := RoundUp
+			err := m.UnmarshalText([]byte(tt.input))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UnmarshalText() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				if m != RoundUp {
+					t.Errorf("UnmarshalText() modified mode on error: %v", m)
+				}
+				return
+			}
+			if m != tt.want {
+				t.Errorf("UnmarshalText() = %v, want %v", m, tt.want)
+			}
+		})
+	}
+}
+
+func TestMode_JSONRoundTrip(t *testing.T) {
+	type config struct {
+		Mode Mode `json:"mode"`
+	}
+
+	for m := RoundDown; m <= RoundFloor; m++ {
+		data, err := json.Marshal(config{Mode: m})
+		if err != nil {
+			t.Errorf("json.Marshal(%v) error = %v", m, err)
+			continue
+		}
+		if want := `{"mode":"` + m.String() + `"}`; string(data) != want {
+			t.Errorf("json.Marshal(%v) = %s, want %s", m, data, want)
+		}
+
+		var got config
+		if err := json.Unmarshal(data, &got); err != nil {
+			t.Errorf("json.Unmarshal(%s) error = %v", data, err)
+			continue
+		}
+		if got.Mode != m {
+			t.Errorf("json.Unmarshal(%s) = %v, want %v", data, got.Mode, m)
+		}
+	}
+
+	if _, err := json.Marshal(config{Mode: Mode(42)}); err == nil {
+		t.Errorf("json.Marshal() of unknown mode expected error")
+	}
+}
+
 func TestRoundFloat64(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -266,6 +468,173 @@ func TestRoundFloat64(t *testing.T) {
 	}
 }
 
+func TestRoundDecimalString(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     string
+		places    int32
+		mode      Mode
+		want      string
+		wantErr   bool
+		errorType error
+	}{
+		{
+			name:   "RoundDown positive",
+			value:  "10.559",
+			places: 2,
+			mode:   RoundDown,
+			want:   "10.55",
+		},
+		{
+			name:   "RoundDown negative",
+			value:  "-10.559",
+			places: 2,
+			mode:   RoundDown,
+			want:   "-10.55",
+		},
+		{
+			name:   "RoundUp positive",
+			value:  "10.551",
+			places: 2,
+			mode:   RoundUp,
+			want:   "10.56",
+		},
+		{
+			name:   "RoundUp negative",
+			value:  "-10.551",
+			places: 2,
+			mode:   RoundUp,
+			want:   "-10.56",
+		},
+		{
+			name:   "RoundHalfUp exactly half",
+			value:  "2.675",
+			places: 2,
+			mode:   RoundHalfUp,
+			want:   "2.68",
+		},
+		{
+			name:   "RoundHalfUp negative exactly half",
+			value:  "-2.675",
+			places: 2,
+			mode:   RoundHalfUp,
+			want:   "-2.68",
+		},
+		{
+			name:   "RoundHalfDown exactly half",
+			value:  "2.675",
+			places: 2,
+			mode:   RoundHalfDown,
+			want:   "2.67",
+		},
+		{
+			name:   "RoundHalfDown negative exactly half",
+			value:  "-2.675",
+			places: 2,
+			mode:   RoundHalfDown,
+			want:   "-2.67",
+		},
+		{
+			name:   "RoundHalfDown more than half",
+			value:  "2.6751",
+			places: 2,
+			mode:   RoundHalfDown,
+			want:   "2.68",
+		},
+		{
+			name:   "RoundHalfEven half to even",
+			value:  "2.665",
+			places: 2,
+			mode:   RoundHalfEven,
+			want:   "2.66",
+		},
+		{
+			name:   "RoundHalfEven half to odd",
+			value:  "2.675",
+			places: 2,
+			mode:   RoundHalfEven,
+			want:   "2.68",
+		},
+		{
+			name:   "RoundCeiling negative",
+			value:  "-10.559",
+			places: 2,
+			mode:   RoundCeiling,
+			want:   "-10.55",
+		},
+		{
+			name:   "RoundFloor negative",
+			value:  "-10.551",
+			places: 2,
+			mode:   RoundFloor,
+			want:   "-10.56",
+		},
+		{
+			name:   "pads to places",
+			value:  "1.5",
+			places: 2,
+			mode:   RoundHalfUp,
+			want:   "1.50",
+		},
+		{
+			name:   "whole units",
+			value:  "12.5",
+			places: 0,
+			mode:   RoundHalfEven,
+			want:   "12",
+		},
+		{
+			name:   "beyond float64 precision",
+			value:  "12345678901234567890.125",
+			places: 2,
+			mode:   RoundHalfEven,
+			want:   "12345678901234567890.12",
+		},
+		{
+			name:    "invalid input",
+			value:   "12.3.4",
+			places:  2,
+			mode:    RoundHalfUp,
+			wantErr: true,
+		},
+		{
+			name:      "negative places",
+			value:     "10.555",
+			places:    -1,
+			mode:      RoundHalfUp,
+			wantErr:   true,
+			errorType: finerrors.ErrInvalidPrecision,
+		},
+		{
+			name:      "invalid rounding mode",
+			value:     "10.555",
+			places:    2,
+			mode:      Mode(99),
+			wantErr:   true,
+			errorType: finerrors.ErrInvalidRounding,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := RoundDecimalString(tt.value, tt.places, tt.mode)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("RoundDecimalString() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				if tt.errorType != nil && !errors.Is(err, tt.errorType) {
+					t.Errorf("RoundDecimalString() error = %v, want %v", err, tt.errorType)
+				}
+				return
+			}
+			if got != tt.want {
+				t.Errorf("RoundDecimalString() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestRoundInt64(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -448,3 +817,388 @@ func TestRoundInt64(t *testing.T) {
 		})
 	}
 }
+
+func TestRoundToPow2(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     int64
+		mode      Mode
+		want      int64
+		wantErr   bool
+		errorType error
+	}{
+		{
+			name:  "100 round up",
+			value: 100,
+			mode:  RoundUp,
+			want:  128,
+		},
+		{
+			name:  "100 round ceiling",
+			value: 100,
+			mode:  RoundCeiling,
+			want:  128,
+		},
+		{
+			name:  "100 round down",
+			value: 100,
+			mode:  RoundDown,
+			want:  64,
+		},
+		{
+			name:  "100 round floor",
+			value: 100,
+			mode:  RoundFloor,
+			want:  64,
+		},
+		{
+			name:  "100 half up nearest is 128",
+			value: 100,
+			mode:  RoundHalfUp,
+			want:  128,
+		},
+		{
+			name:  "90 half up nearest is 64",
+			value: 90,
+			mode:  RoundHalfUp,
+			want:  64,
+		},
+		{
+			name:  "exact power of two",
+			value: 64,
+			mode:  RoundUp,
+			want:  64,
+		},
+		{
+			name:  "one",
+			value: 1,
+			mode:  RoundUp,
+			want:  1,
+		},
+		{
+			name:  "midpoint 96 half up",
+			value: 96,
+			mode:  RoundHalfUp,
+			want:  128,
+		},
+		{
+			name:  "midpoint 96 half down",
+			value: 96,
+			mode:  RoundHalfDown,
+			want:  64,
+		},
+		{
+			name:  "midpoint 96 half even",
+			value: 96,
+			mode:  RoundHalfEven,
+			want:  64,
+		},
+		{
+			name:  "midpoint 3 half even",
+			value: 3,
+			mode:  RoundHalfEven,
+			want:  4,
+		},
+		{
+			name:  "midpoint 12 half even",
+			value: 12,
+			mode:  RoundHalfEven,
+			want:  16,
+		},
+		{
+			name:  "1 round half up",
+			value: 1,
+			mode:  RoundHalfUp,
+			want:  1,
+		},
+		{
+			name:  "1 round half down",
+			value: 1,
+			mode:  RoundHalfDown,
+			want:  1,
+		},
+		{
+			name:  "1 round half even",
+			value: 1,
+			mode:  RoundHalfEven,
+			want:  1,
+		},
+		{
+			name:  "2 round half up",
+			value: 2,
+			mode:  RoundHalfUp,
+			want:  2,
+		},
+		{
+			name:  "2 round half down",
+			value: 2,
+			mode:  RoundHalfDown,
+			want:  2,
+		},
+		{
+			name:  "2 round half even",
+			value: 2,
+			mode:  RoundHalfEven,
+			want:  2,
+		},
+		{
+			name:  "max int64 round down",
+			value: math.MaxInt64,
+			mode:  RoundDown,
+			want:  1 << 62,
+		},
+		{
+			name:      "max int64 round up overflows",
+			value:     math.MaxInt64,
+			mode:      RoundUp,
+			wantErr:   true,
+			errorType: finerrors.ErrOverflow,
+		},
+		{
+			name:      "zero",
+			value:     0,
+			mode:      RoundUp,
+			wantErr:   true,
+			errorType: finerrors.ErrInvalidArgument,
+		},
+		{
+			name:      "negative",
+			value:     -8,
+			mode:      RoundDown,
+			wantErr:   true,
+			errorType: finerrors.ErrInvalidArgument,
+		},
+		{
+			name:      "invalid rounding mode",
+			value:     100,
+			mode:      Mode(99),
+			wantErr:   true,
+			errorType: finerrors.ErrInvalidRounding,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := RoundToPow2(tt.value, tt.mode)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("RoundToPow2() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil && !errors.Is(err, tt.errorType) {
+				t.Errorf("RoundToPow2() error = %v, want %v", err, tt.errorType)
+			}
+			if got != tt.want {
+				t.Errorf("RoundToPow2() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRoundInt64ToIncrement(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     int64
+		increment int64
+		mode      Mode
+		want      int64
+		wantErr   bool
+		errorType error
+	}{
+		{
+			name:      "cash half up rounds down",
+			value:     1237,
+			increment: 5,
+			mode:      RoundHalfUp,
+			want:      1235,
+		},
+		{
+			name:      "cash half up rounds up",
+			value:     1238,
+			increment: 5,
+			mode:      RoundHalfUp,
+			want:      1240,
+		},
+		{
+			name:      "cash negative half up",
+			value:     -1238,
+			increment: 5,
+			mode:      RoundHalfUp,
+			want:      -1240,
+		},
+		{
+			name:      "cash negative half up rounds toward zero",
+			value:     -1237,
+			increment: 5,
+			mode:      RoundHalfUp,
+			want:      -1235,
+		},
+		{
+			name:      "quarter increment",
+			value:     110,
+			increment: 25,
+			mode:      RoundHalfUp,
+			want:      100,
+		},
+		{
+			name:      "midpoint half up",
+			value:     125,
+			increment: 50,
+			mode:      RoundHalfUp,
+			want:      150,
+		},
+		{
+			name:      "midpoint half down",
+			value:     125,
+			increment: 50,
+			mode:      RoundHalfDown,
+			want:      100,
+		},
+		{
+			name:      "midpoint half even to even below",
+			value:     125,
+			increment: 50,
+			mode:      RoundHalfEven,
+			want:      100,
+		},
+		{
+			name:      "midpoint half even to even above",
+			value:     175,
+			increment: 50,
+			mode:      RoundHalfEven,
+			want:      200,
+		},
+		{
+			name:      "negative midpoint half up",
+			value:     -125,
+			increment: 50,
+			mode:      RoundHalfUp,
+			want:      -150,
+		},
+		{
+			name:      "negative midpoint half down",
+			value:     -125,
+			increment: 50,
+			mode:      RoundHalfDown,
+			want:      -100,
+		},
+		{
+			name:      "negative midpoint half even",
+			value:     -175,
+			increment: 50,
+			mode:      RoundHalfEven,
+			want:      -200,
+		},
+		{
+			name:      "round down negative",
+			value:     -1238,
+			increment: 5,
+			mode:      RoundDown,
+			want:      -1235,
+		},
+		{
+			name:      "round up negative",
+			value:     -1236,
+			increment: 5,
+			mode:      RoundUp,
+			want:      -1240,
+		},
+		{
+			name:      "ceiling negative",
+			value:     -1238,
+			increment: 5,
+			mode:      RoundCeiling,
+			want:      -1235,
+		},
+		{
+			name:      "floor negative",
+			value:     -1236,
+			increment: 5,
+			mode:      RoundFloor,
+			want:      -1240,
+		},
+		{
+			name:      "odd increment",
+			value:     5,
+			increment: 3,
+			mode:      RoundHalfUp,
+			want:      6,
+		},
+		{
+			name:      "already a multiple",
+			value:     1240,
+			increment: 5,
+			mode:      RoundUp,
+			want:      1240,
+		},
+		{
+			name:      "max int64 round down",
+			value:     math.MaxInt64,
+			increment: 10,
+			mode:      RoundDown,
+			want:      9223372036854775800,
+		},
+		{
+			name:      "min int64 round down",
+			value:     math.MinInt64,
+			increment: 10,
+			mode:      RoundDown,
+			want:      -9223372036854775800,
+		},
+		{
+			name:      "zero increment",
+			value:     1237,
+			increment: 0,
+			mode:      RoundHalfUp,
+			wantErr:   true,
+			errorType: finerrors.ErrInvalidArgument,
+		},
+		{
+			name:      "negative increment",
+			value:     1237,
+			increment: -5,
+			mode:      RoundHalfUp,
+			wantErr:   true,
+			errorType: finerrors.ErrInvalidArgument,
+		},
+		{
+			name:      "invalid rounding mode",
+			value:     1240,
+			increment: 5,
+			mode:      Mode(99),
+			wantErr:   true,
+			errorType: finerrors.ErrInvalidRounding,
+		},
+		{
+			name:      "max int64 round up overflows",
+			value:     math.MaxInt64,
+			increment: 10,
+			mode:      RoundUp,
+			wantErr:   true,
+			errorType: finerrors.ErrOverflow,
+		},
+		{
+			name:      "min int64 round up overflows",
+			value:     math.MinInt64,
+			increment: 10,
+			mode:      RoundUp,
+			wantErr:   true,
+			errorType: finerrors.ErrOverflow,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := RoundInt64ToIncrement(tt.value, tt.increment, tt.mode)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("RoundInt64ToIncrement() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil && !errors.Is(err, tt.errorType) {
+				t.Errorf("RoundInt64ToIncrement() error = %v, want %v", err, tt.errorType)
+			}
+			if got != tt.want {
+				t.Errorf("RoundInt64ToIncrement() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
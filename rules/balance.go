@@ -0,0 +1,28 @@
+package rules
+
+import (
+	"github.com/nduyhai/finarith/errors"
+	"github.com/nduyhai/finarith/safedec"
+)
+
+// ValidateBalanceSequence applies the deltas in order to the opening balance and checks that
+// the running balance never becomes negative.
+// Returns the index of the first delta that would make the balance negative together with
+// ErrNegativeValue, or -1 and nil if the balance stays non-negative throughout.
+// A negative opening balance is rejected with -1 and ErrNegativeValue.
+func ValidateBalanceSequence(opening safedec.Decimal, deltas []safedec.Decimal) (int, error) {
+	if opening.IsNegative() {
+		return -1, errors.ErrNegativeValue
+	}
+
+	balance := opening
+	for i, delta := range deltas {
+		next, err := balance.SubNonNegative(delta.Neg())
+		if err != nil {
+			return i, err
+		}
+		balance = next
+	}
+
+	return -1, nil
+}
@@ -0,0 +1,79 @@
+package rules
+
+import (
+	"errors"
+	"testing"
+
+	finerrors "github.com/nduyhai/finarith/errors"
+	"github.com/nduyhai/finarith/safedec"
+)
+
+func TestValidateBalanceSequence(t *testing.T) {
+	tests := []struct {
+		name      string
+		opening   string
+		deltas    []string
+		wantIndex int
+		wantErr   bool
+	}{
+		{
+			name:      "balance stays positive",
+			opening:   "100.00",
+			deltas:    []string{"-20.00", "50.00", "-130.00"},
+			wantIndex: -1,
+			wantErr:   false,
+		},
+		{
+			name:      "third delta overdraws",
+			opening:   "100.00",
+			deltas:    []string{"-40.00", "-50.00", "-10.01", "500.00"},
+			wantIndex: 2,
+			wantErr:   true,
+		},
+		{
+			name:      "first delta overdraws",
+			opening:   "0",
+			deltas:    []string{"-0.01"},
+			wantIndex: 0,
+			wantErr:   true,
+		},
+		{
+			name:      "no deltas",
+			opening:   "10.00",
+			deltas:    []string{},
+			wantIndex: -1,
+			wantErr:   false,
+		},
+		{
+			name:      "negative opening balance",
+			opening:   "-10.00",
+			deltas:    []string{"20.00"},
+			wantIndex: -1,
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opening, _ := safedec.NewFromString(tt.opening)
+			deltas := make([]safedec.Decimal, len(tt.deltas))
+			for i, delta := range tt.deltas {
+				deltas[i], _ = safedec.NewFromString(delta)
+			}
+
+			got, err := ValidateBalanceSequence(opening, deltas)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateBalanceSequence() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if err != nil && !errors.Is(err, finerrors.ErrNegativeValue) {
+				t.Errorf("ValidateBalanceSequence() error type = %v, want %v", err, finerrors.ErrNegativeValue)
+			}
+
+			if got != tt.wantIndex {
+				t.Errorf("ValidateBalanceSequence() = %v, want %v", got, tt.wantIndex)
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package rules
+
+import (
+	"github.com/nduyhai/finarith/errors"
+	"github.com/nduyhai/finarith/rounding"
+	"github.com/nduyhai/finarith/safedec"
+)
+
+// CurrencyConversionRule represents a rule for converting amounts between currencies at a quoted
+// exchange rate less a spread.
+type CurrencyConversionRule struct {
+	// Rate is the number of units of the target currency per unit of the source currency.
+	Rate safedec.Decimal
+
+	// SpreadPercent is the percentage of the converted amount retained as the spread.
+	SpreadPercent safedec.Decimal
+
+	// RoundingMode is the rounding mode to use for converted amounts.
+	RoundingMode rounding.Mode
+
+	// RoundingPrecision is the number of decimal places to round to.
+	RoundingPrecision int32
+}
+
+// NewCurrencyConversionRule creates a new CurrencyConversionRule with the specified parameters.
+func NewCurrencyConversionRule(rate, spreadPercent safedec.Decimal, roundingMode rounding.Mode, roundingPrecision int32) *CurrencyConversionRule {
+	return &CurrencyConversionRule{
+		Rate:              rate,
+		SpreadPercent:     spreadPercent,
+		RoundingMode:      roundingMode,
+		RoundingPrecision: roundingPrecision,
+	}
+}
+
+// Convert converts the amount at the rule's rate, deducts the spread percentage from the result,
+// and rounds it according to the rule's rounding mode and precision.
+// Returns ErrNegativeValue if the rate or spread is negative, ErrInvalidArgument if the rate is
+// zero or the spread exceeds 100 percent, or an error if the rounding mode is invalid.
+func (r *CurrencyConversionRule) Convert(amount safedec.Decimal) (safedec.Decimal, error) {
+	if r.Rate.IsNegative() || r.SpreadPercent.IsNegative() {
+		return safedec.Zero(), errors.ErrNegativeValue
+	}
+	if r.Rate.IsZero() {
+		return safedec.Zero(), errors.ErrInvalidArgument
+	}
+
+	hundred := safedec.NewFromInt(100)
+	if r.SpreadPercent.GreaterThan(hundred) {
+		return safedec.Zero(), errors.ErrInvalidArgument
+	}
+
+	// Apply the spread to the converted amount
+	converted := amount.Mul(r.Rate)
+	converted, err := converted.Mul(hundred.Sub(r.SpreadPercent)).Div(hundred)
+	if err != nil {
+		return safedec.Zero(), err
+	}
+
+	return converted.Round(r.RoundingPrecision, r.RoundingMode)
+}
@@ -0,0 +1,136 @@
+package rules
+
+import (
+	"errors"
+	"testing"
+
+	finerrors "github.com/nduyhai/finarith/errors"
+	"github.com/nduyhai/finarith/rounding"
+	"github.com/nduyhai/finarith/safedec"
+)
+
+func TestNewCurrencyConversionRule(t *testing.T) {
+	rate, _ := safedec.NewFromString("0.9215")
+	spreadPercent, _ := safedec.NewFromString("1.50")
+
+	rule := NewCurrencyConversionRule(rate, spreadPercent, rounding.RoundHalfEven, 2)
+
+	if !rule.Rate.Equal(rate) {
+		t.Errorf("NewCurrencyConversionRule() Rate = %v, want %v", rule.Rate, rate)
+	}
+	if !rule.SpreadPercent.Equal(spreadPercent) {
+		t.Errorf("NewCurrencyConversionRule() SpreadPercent = %v, want %v", rule.SpreadPercent, spreadPercent)
+	}
+	if rule.RoundingMode != rounding.RoundHalfEven {
+		t.Errorf("NewCurrencyConversionRule() RoundingMode = %v, want %v", rule.RoundingMode, rounding.RoundHalfEven)
+	}
+	if rule.RoundingPrecision != 2 {
+		t.Errorf("NewCurrencyConversionRule() RoundingPrecision = %v, want %v", rule.RoundingPrecision, 2)
+	}
+}
+
+func TestCurrencyConversionRule_Convert(t *testing.T) {
+	tests := []struct {
+		name      string
+		rate      string
+		spread    string
+		precision int32
+		amount    string
+		want      string
+		wantErr   bool
+		errorType error
+	}{
+		{
+			name:      "no spread",
+			rate:      "0.9215",
+			spread:    "0",
+			precision: 2,
+			amount:    "100.00",
+			want:      "92.15",
+		},
+		{
+			name:      "with spread",
+			rate:      "0.9215",
+			spread:    "1.5",
+			precision: 2,
+			amount:    "100.00",
+			want:      "90.77",
+		},
+		{
+			name:      "rounded to zero decimal places",
+			rate:      "151.37",
+			spread:    "2",
+			precision: 0,
+			amount:    "250.00",
+			want:      "37086",
+		},
+		{
+			name:      "full spread",
+			rate:      "1.1",
+			spread:    "100",
+			precision: 2,
+			amount:    "50",
+			want:      "0",
+		},
+		{
+			name:      "negative rate",
+			rate:      "-0.9215",
+			spread:    "1.5",
+			precision: 2,
+			amount:    "100.00",
+			wantErr:   true,
+			errorType: finerrors.ErrNegativeValue,
+		},
+		{
+			name:      "zero rate",
+			rate:      "0",
+			spread:    "1.5",
+			precision: 2,
+			amount:    "100.00",
+			wantErr:   true,
+			errorType: finerrors.ErrInvalidArgument,
+		},
+		{
+			name:      "negative spread",
+			rate:      "0.9215",
+			spread:    "-1",
+			precision: 2,
+			amount:    "100.00",
+			wantErr:   true,
+			errorType: finerrors.ErrNegativeValue,
+		},
+		{
+			name:      "spread over 100 percent",
+			rate:      "0.9215",
+			spread:    "100.01",
+			precision: 2,
+			amount:    "100.00",
+			wantErr:   true,
+			errorType: finerrors.ErrInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rate, _ := safedec.NewFromString(tt.rate)
+			spread, _ := safedec.NewFromString(tt.spread)
+			amount, _ := safedec.NewFromString(tt.amount)
+			rule := NewCurrencyConversionRule(rate, spread, rounding.RoundHalfEven, tt.precision)
+
+			got, err := rule.Convert(amount)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Convert() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				if !errors.Is(err, tt.errorType) {
+					t.Errorf("Convert() error type = %v, want %v", err, tt.errorType)
+				}
+				return
+			}
+			if got.String() != tt.want {
+				t.Errorf("Convert() = %v, want %v", got.String(), tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package rules
+
+import (
+	"github.com/nduyhai/finarith/errors"
+	"github.com/nduyhai/finarith/safedec"
+)
+
+// CoveredAmount computes deposit insurance coverage across accounts, where each account is
+// insured up to limitPerAccount. It returns the total covered amount, the sum of min(balance, limit)
+// over all accounts, and the total uncovered amount above the limit.
+// Returns ErrNegativeValue if the limit or any balance is negative.
+func CoveredAmount(balances []safedec.Decimal, limitPerAccount safedec.Decimal) (covered, uncovered safedec.Decimal, err error) {
+	if limitPerAccount.IsNegative() {
+		return safedec.Zero(), safedec.Zero(), errors.ErrNegativeValue
+	}
+
+	covered = safedec.Zero()
+	uncovered = safedec.Zero()
+	for _, balance := range balances {
+		if balance.IsNegative() {
+			return safedec.Zero(), safedec.Zero(), errors.ErrNegativeValue
+		}
+
+		insured := safedec.MinValue(balance, limitPerAccount)
+		covered = covered.Add(insured)
+		uncovered = uncovered.Add(balance.Sub(insured))
+	}
+
+	return covered, uncovered, nil
+}
@@ -0,0 +1,89 @@
+package rules
+
+import (
+	"errors"
+	"testing"
+
+	finerrors "github.com/nduyhai/finarith/errors"
+	"github.com/nduyhai/finarith/safedec"
+)
+
+func TestCoveredAmount(t *testing.T) {
+	tests := []struct {
+		name          string
+		balances      []string
+		limit         string
+		wantCovered   string
+		wantUncovered string
+		wantErr       bool
+	}{
+		{
+			name:          "all accounts below limit",
+			balances:      []string{"1000.00", "249999.99"},
+			limit:         "250000",
+			wantCovered:   "250999.99",
+			wantUncovered: "0",
+		},
+		{
+			name:          "accounts above and below limit",
+			balances:      []string{"300000.00", "100000.00", "250000.00"},
+			limit:         "250000",
+			wantCovered:   "600000",
+			wantUncovered: "50000",
+		},
+		{
+			name:          "no accounts",
+			balances:      []string{},
+			limit:         "250000",
+			wantCovered:   "0",
+			wantUncovered: "0",
+		},
+		{
+			name:          "zero limit",
+			balances:      []string{"10.00"},
+			limit:         "0",
+			wantCovered:   "0",
+			wantUncovered: "10",
+		},
+		{
+			name:     "negative balance",
+			balances: []string{"100.00", "-0.01"},
+			limit:    "250000",
+			wantErr:  true,
+		},
+		{
+			name:     "negative limit",
+			balances: []string{"100.00"},
+			limit:    "-1",
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			balances := make([]safedec.Decimal, len(tt.balances))
+			for i, b := range tt.balances {
+				balances[i], _ = safedec.NewFromString(b)
+			}
+			limit, _ := safedec.NewFromString(tt.limit)
+
+			covered, uncovered, err := CoveredAmount(balances, limit)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CoveredAmount() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				if !errors.Is(err, finerrors.ErrNegativeValue) {
+					t.Errorf("CoveredAmount() error = %v, want error type %v", err, finerrors.ErrNegativeValue)
+				}
+				return
+			}
+			if covered.String() != tt.wantCovered {
+				t.Errorf("CoveredAmount() covered = %v, want %v", covered.String(), tt.wantCovered)
+			}
+			if uncovered.String() != tt.wantUncovered {
+				t.Errorf("CoveredAmount() uncovered = %v, want %v", uncovered.String(), tt.wantUncovered)
+			}
+		})
+	}
+}
@@ -0,0 +1,152 @@
+package rules
+
+import (
+	"github.com/shopspring/decimal"
+
+	"github.com/nduyhai/finarith/errors"
+	"github.com/nduyhai/finarith/rounding"
+	"github.com/nduyhai/finarith/safedec"
+)
+
+// FeeRule represents a rule for calculating a percentage-plus-fixed fee.
+type FeeRule struct {
+	// PercentRate is the variable part of the fee as a percentage of the amount.
+	PercentRate safedec.Decimal
+
+	// FixedFee is the fixed part of the fee charged on every amount.
+	FixedFee safedec.Decimal
+
+	// MinFee, if set, is the smallest fee charged. Nil means no minimum.
+	MinFee *safedec.Decimal
+
+	// MaxFee, if set, caps the fee charged. Nil means no cap.
+	MaxFee *safedec.Decimal
+
+	// RoundingMode is the rounding mode to use for fee calculations.
+	RoundingMode rounding.Mode
+
+	// RoundingPrecision is the number of decimal places to round to.
+	RoundingPrecision int32
+}
+
+// NewFeeRule creates a new FeeRule with the specified parameters.
+func NewFeeRule(percentRate, fixedFee safedec.Decimal, roundingMode rounding.Mode, roundingPrecision int32) *FeeRule {
+	return &FeeRule{
+		PercentRate:       percentRate,
+		FixedFee:          fixedFee,
+		RoundingMode:      roundingMode,
+		RoundingPrecision: roundingPrecision,
+	}
+}
+
+// NewFeeRuleWithLimits creates a new FeeRule whose fee is kept between minFee and maxFee.
+// Either limit may be nil to leave that side unbounded.
+func NewFeeRuleWithLimits(percentRate, fixedFee safedec.Decimal, minFee, maxFee *safedec.Decimal, roundingMode rounding.Mode, roundingPrecision int32) *FeeRule {
+	rule := NewFeeRule(percentRate, fixedFee, roundingMode, roundingPrecision)
+	rule.MinFee = minFee
+	rule.MaxFee = maxFee
+	return rule
+}
+
+// CalculateFee calculates the fee charged on the given amount as the percentage of the amount
+// plus the fixed fee, rounded and then raised to MinFee or lowered to MaxFee when they are set.
+// Returns an error if the rounding mode is invalid, or an error wrapping ErrInvalidRange if
+// MinFee is greater than MaxFee.
+func (r *FeeRule) CalculateFee(amount safedec.Decimal) (safedec.Decimal, error) {
+	variableFee := amount.PercentageUnchecked(r.PercentRate)
+
+	fee, err := variableFee.Add(r.FixedFee).Round(r.RoundingPrecision, r.RoundingMode)
+	if err != nil {
+		return safedec.Zero(), err
+	}
+
+	return r.limit(fee)
+}
+
+// limit applies MinFee and MaxFee, when they are set, to the fee.
+func (r *FeeRule) limit(fee safedec.Decimal) (safedec.Decimal, error) {
+	switch {
+	case r.MinFee != nil && r.MaxFee != nil:
+		return fee.Clamp(*r.MinFee, *r.MaxFee)
+	case r.MinFee != nil:
+		return safedec.MaxValue(fee, *r.MinFee), nil
+	case r.MaxFee != nil:
+		return safedec.MinValue(fee, *r.MaxFee), nil
+	default:
+		return fee, nil
+	}
+}
+
+// GrossUp calculates the smallest gross amount, at the rule's rounding precision, whose net amount
+// after fees is at least targetNet. It solves gross = (targetNet + fixed) / (1 - rate/100) and then
+// corrects for the rounding and limits of the fee.
+// Returns an error if targetNet is negative, if the percentage rate is 100 or more,
+// if the rounding mode is invalid, or if MinFee is greater than MaxFee.
+func GrossUp(targetNet safedec.Decimal, feeRule *FeeRule) (gross safedec.Decimal, err error) {
+	if targetNet.IsNegative() {
+		return safedec.Zero(), errors.ErrNegativeValue
+	}
+
+	hundred := safedec.NewFromInt(100)
+	if feeRule.PercentRate.GreaterThanOrEqual(hundred) {
+		return safedec.Zero(), errors.NewLimitError(feeRule.PercentRate.String(), hundred.String(), "fee percentage")
+	}
+
+	// Solve the equation algebraically and round up to the fee precision
+	netRatio, err := hundred.Sub(feeRule.PercentRate).Div(hundred)
+	if err != nil {
+		return safedec.Zero(), err
+	}
+	exact, err := targetNet.Add(feeRule.FixedFee).Div(netRatio)
+	if err != nil {
+		return safedec.Zero(), err
+	}
+
+	// Where the fee limits apply, the fee is the limit itself rather than the formula
+	if feeRule.MinFee != nil {
+		exact = safedec.MaxValue(exact, targetNet.Add(*feeRule.MinFee))
+	}
+	if feeRule.MaxFee != nil {
+		exact = safedec.MinValue(exact, targetNet.Add(*feeRule.MaxFee))
+	}
+	gross, err = exact.Round(feeRule.RoundingPrecision, rounding.RoundCeiling)
+	if err != nil {
+		return safedec.Zero(), err
+	}
+
+	// Fee rounding can shift the net amount by a unit either way, so settle on the smallest
+	// gross amount that still reaches the target
+	unit := safedec.New(decimal.New(1, -feeRule.RoundingPrecision))
+	for {
+		net, err := feeRule.net(gross)
+		if err != nil {
+			return safedec.Zero(), err
+		}
+		if net.GreaterThanOrEqual(targetNet) {
+			break
+		}
+		gross = gross.Add(unit)
+	}
+	for {
+		lower := gross.Sub(unit)
+		net, err := feeRule.net(lower)
+		if err != nil {
+			return safedec.Zero(), err
+		}
+		if net.LessThan(targetNet) {
+			break
+		}
+		gross = lower
+	}
+
+	return gross, nil
+}
+
+// net returns the amount left after the fee is deducted from gross.
+func (r *FeeRule) net(gross safedec.Decimal) (safedec.Decimal, error) {
+	fee, err := r.CalculateFee(gross)
+	if err != nil {
+		return safedec.Zero(), err
+	}
+	return gross.Sub(fee), nil
+}
@@ -0,0 +1,319 @@
+package rules
+
+import (
+	"errors"
+	"testing"
+
+	finerrors "github.com/nduyhai/finarith/errors"
+	"github.com/nduyhai/finarith/rounding"
+	"github.com/nduyhai/finarith/safedec"
+)
+
+func TestNewFeeRule(t *testing.T) {
+	percentRate, _ := safedec.NewFromString("2.9")
+	fixedFee, _ := safedec.NewFromString("0.30")
+
+	rule := NewFeeRule(percentRate, fixedFee, rounding.RoundHalfUp, 2)
+
+	if !rule.PercentRate.Equal(percentRate) {
+		t.Errorf("NewFeeRule() PercentRate = %v, want %v", rule.PercentRate, percentRate)
+	}
+	if !rule.FixedFee.Equal(fixedFee) {
+		t.Errorf("NewFeeRule() FixedFee = %v, want %v", rule.FixedFee, fixedFee)
+	}
+	if rule.RoundingMode != rounding.RoundHalfUp {
+		t.Errorf("NewFeeRule() RoundingMode = %v, want %v", rule.RoundingMode, rounding.RoundHalfUp)
+	}
+	if rule.RoundingPrecision != 2 {
+		t.Errorf("NewFeeRule() RoundingPrecision = %v, want %v", rule.RoundingPrecision, 2)
+	}
+}
+
+func TestFeeRule_CalculateFee(t *testing.T) {
+	tests := []struct {
+		name   string
+		amount string
+		want   string
+	}{
+		{
+			name:   "round amount",
+			amount: "100.00",
+			want:   "3.2",
+		},
+		{
+			name:   "rounding applied",
+			amount: "103.30",
+			want:   "3.3",
+		},
+		{
+			name:   "zero amount",
+			amount: "0",
+			want:   "0.3",
+		},
+	}
+
+	percentRate, _ := safedec.NewFromString("2.9")
+	fixedFee, _ := safedec.NewFromString("0.30")
+	rule := NewFeeRule(percentRate, fixedFee, rounding.RoundHalfUp, 2)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			amount, _ := safedec.NewFromString(tt.amount)
+
+			got, err := rule.CalculateFee(amount)
+			if err != nil {
+				t.Errorf("CalculateFee() error = %v", err)
+				return
+			}
+			if got.String() != tt.want {
+				t.Errorf("CalculateFee() = %v, want %v", got.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestNewFeeRuleWithLimits(t *testing.T) {
+	percentRate, _ := safedec.NewFromString("2.9")
+	fixedFee, _ := safedec.NewFromString("0.30")
+	minFee, _ := safedec.NewFromString("0.50")
+	maxFee, _ := safedec.NewFromString("25.00")
+
+	rule := NewFeeRuleWithLimits(percentRate, fixedFee, &minFee, &maxFee, rounding.RoundHalfUp, 2)
+
+	if !rule.PercentRate.Equal(percentRate) {
+		t.Errorf("NewFeeRuleWithLimits() PercentRate = %v, want %v", rule.PercentRate, percentRate)
+	}
+	if !rule.FixedFee.Equal(fixedFee) {
+		t.Errorf("NewFeeRuleWithLimits() FixedFee = %v, want %v", rule.FixedFee, fixedFee)
+	}
+	if rule.MinFee == nil || !rule.MinFee.Equal(minFee) {
+		t.Errorf("NewFeeRuleWithLimits() MinFee = %v, want %v", rule.MinFee, minFee)
+	}
+	if rule.MaxFee == nil || !rule.MaxFee.Equal(maxFee) {
+		t.Errorf("NewFeeRuleWithLimits() MaxFee = %v, want %v", rule.MaxFee, maxFee)
+	}
+	if rule.RoundingMode != rounding.RoundHalfUp {
+		t.Errorf("NewFeeRuleWithLimits() RoundingMode = %v, want %v", rule.RoundingMode, rounding.RoundHalfUp)
+	}
+	if rule.RoundingPrecision != 2 {
+		t.Errorf("NewFeeRuleWithLimits() RoundingPrecision = %v, want %v", rule.RoundingPrecision, 2)
+	}
+}
+
+func TestFeeRule_CalculateFeeWithLimits(t *testing.T) {
+	tests := []struct {
+		name    string
+		minFee  string
+		maxFee  string
+		amount  string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:   "between limits",
+			minFee: "0.50",
+			maxFee: "25.00",
+			amount: "100.00",
+			want:   "3.2",
+		},
+		{
+			name:   "floor hit",
+			minFee: "0.50",
+			maxFee: "25.00",
+			amount: "5.00",
+			want:   "0.5",
+		},
+		{
+			name:   "cap hit",
+			minFee: "0.50",
+			maxFee: "25.00",
+			amount: "2000.00",
+			want:   "25",
+		},
+		{
+			name:   "floor only",
+			minFee: "0.50",
+			amount: "5.00",
+			want:   "0.5",
+		},
+		{
+			name:   "cap only",
+			maxFee: "25.00",
+			amount: "2000.00",
+			want:   "25",
+		},
+		{
+			name:    "minimum above cap",
+			minFee:  "30.00",
+			maxFee:  "25.00",
+			amount:  "100.00",
+			wantErr: true,
+		},
+	}
+
+	percentRate, _ := safedec.NewFromString("2.9")
+	fixedFee, _ := safedec.NewFromString("0.30")
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var minFee, maxFee *safedec.Decimal
+			if tt.minFee != "" {
+				d, _ := safedec.NewFromString(tt.minFee)
+				minFee = &d
+			}
+			if tt.maxFee != "" {
+				d, _ := safedec.NewFromString(tt.maxFee)
+				maxFee = &d
+			}
+			rule := NewFeeRuleWithLimits(percentRate, fixedFee, minFee, maxFee, rounding.RoundHalfUp, 2)
+			amount, _ := safedec.NewFromString(tt.amount)
+
+			got, err := rule.CalculateFee(amount)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CalculateFee() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				if !errors.Is(err, finerrors.ErrInvalidRange) {
+					t.Errorf("CalculateFee() error type = %v, want %v", err, finerrors.ErrInvalidRange)
+				}
+				return
+			}
+			if got.String() != tt.want {
+				t.Errorf("CalculateFee() = %v, want %v", got.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestGrossUpWithLimits(t *testing.T) {
+	tests := []struct {
+		name      string
+		targetNet string
+		want      string
+	}{
+		{
+			name:      "floor applies",
+			targetNet: "5.00",
+			want:      "5.5",
+		},
+		{
+			name:      "between limits",
+			targetNet: "100.00",
+			want:      "103.3",
+		},
+		{
+			name:      "cap applies",
+			targetNet: "100000.00",
+			want:      "100025",
+		},
+	}
+
+	percentRate, _ := safedec.NewFromString("2.9")
+	fixedFee, _ := safedec.NewFromString("0.30")
+	minFee, _ := safedec.NewFromString("0.50")
+	maxFee, _ := safedec.NewFromString("25.00")
+	rule := NewFeeRuleWithLimits(percentRate, fixedFee, &minFee, &maxFee, rounding.RoundHalfUp, 2)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			targetNet, _ := safedec.NewFromString(tt.targetNet)
+
+			got, err := GrossUp(targetNet, rule)
+			if err != nil {
+				t.Errorf("GrossUp() error = %v", err)
+				return
+			}
+			if got.String() != tt.want {
+				t.Errorf("GrossUp() = %v, want %v", got.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestGrossUp(t *testing.T) {
+	tests := []struct {
+		name        string
+		targetNet   string
+		percentRate string
+		fixedFee    string
+		want        string
+		wantErr     bool
+		errorType   error
+	}{
+		{
+			name:        "percentage plus fixed fee",
+			targetNet:   "100.00",
+			percentRate: "2.9",
+			fixedFee:    "0.30",
+			want:        "103.3",
+		},
+		{
+			name:        "fixed fee only",
+			targetNet:   "100.00",
+			percentRate: "0",
+			fixedFee:    "0.30",
+			want:        "100.3",
+		},
+		{
+			name:        "percentage fee only",
+			targetNet:   "97.00",
+			percentRate: "3",
+			fixedFee:    "0",
+			want:        "100",
+		},
+		{
+			name:        "zero target",
+			targetNet:   "0",
+			percentRate: "2.9",
+			fixedFee:    "0.30",
+			want:        "0.31",
+		},
+		{
+			name:        "negative target",
+			targetNet:   "-1.00",
+			percentRate: "2.9",
+			fixedFee:    "0.30",
+			wantErr:     true,
+			errorType:   finerrors.ErrNegativeValue,
+		},
+		{
+			name:        "percentage of 100 or more",
+			targetNet:   "100.00",
+			percentRate: "100",
+			fixedFee:    "0.30",
+			wantErr:     true,
+			errorType:   finerrors.ErrExceedsLimit,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			targetNet, _ := safedec.NewFromString(tt.targetNet)
+			percentRate, _ := safedec.NewFromString(tt.percentRate)
+			fixedFee, _ := safedec.NewFromString(tt.fixedFee)
+			rule := NewFeeRule(percentRate, fixedFee, rounding.RoundHalfUp, 2)
+
+			got, err := GrossUp(targetNet, rule)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GrossUp() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				if !errors.Is(err, tt.errorType) {
+					t.Errorf("GrossUp() error type = %v, want %v", err, tt.errorType)
+				}
+				return
+			}
+
+			if got.String() != tt.want {
+				t.Errorf("GrossUp() = %v, want %v", got.String(), tt.want)
+			}
+
+			fee, _ := rule.CalculateFee(got)
+			if net := got.Sub(fee); net.LessThan(targetNet) {
+				t.Errorf("GrossUp() net after fees = %v, want at least %v", net.String(), targetNet.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package rules
+
+import (
+	"github.com/nduyhai/finarith/errors"
+	"github.com/nduyhai/finarith/rounding"
+	"github.com/nduyhai/finarith/safeint"
+)
+
+// percentTimes100Scale is the value of 100% when percentages are expressed times 100 (e.g. 1550 for 15.50%).
+const percentTimes100Scale = 10000
+
+// IntDiscountRule represents a rule for applying discounts to amounts expressed in minor currency units (e.g. cents).
+// It mirrors DiscountRule for pipelines that only work with int64 amounts.
+type IntDiscountRule struct {
+	// MaxDiscountPercentTimes100 is the maximum discount percentage allowed, times 100 (e.g. 3000 for 30%).
+	MaxDiscountPercentTimes100 int64
+
+	// MinPurchaseAmount is the minimum purchase amount required for a discount, in minor units.
+	MinPurchaseAmount int64
+
+	// MaxDiscountAmount is the maximum absolute discount amount allowed, in minor units.
+	MaxDiscountAmount int64
+
+	// RoundingMode is the rounding mode to use when the discount is not a whole number of minor units.
+	RoundingMode rounding.Mode
+}
+
+// NewIntDiscountRule creates a new IntDiscountRule with the specified constraints.
+func NewIntDiscountRule(maxDiscountPercentTimes100, minPurchaseAmount, maxDiscountAmount int64, roundingMode rounding.Mode) *IntDiscountRule {
+	return &IntDiscountRule{
+		MaxDiscountPercentTimes100: maxDiscountPercentTimes100,
+		MinPurchaseAmount:          minPurchaseAmount,
+		MaxDiscountAmount:          maxDiscountAmount,
+		RoundingMode:               roundingMode,
+	}
+}
+
+// CalculateDiscount calculates the discount amount in minor units based on the purchase amount in
+// minor units and the discount percentage times 100.
+// Returns an error if the discount violates any of the rules, if the calculation overflows,
+// or if the rounding mode is invalid.
+func (r *IntDiscountRule) CalculateDiscount(purchaseCents int64, percentTimes100 int64) (int64, error) {
+	// Check if the purchase amount meets the minimum requirement
+	if purchaseCents < r.MinPurchaseAmount {
+		return 0, errors.NewLimitError(purchaseCents, r.MinPurchaseAmount, "minimum purchase for discount")
+	}
+
+	if percentTimes100 < 0 {
+		return 0, errors.ErrNegativeValue
+	}
+
+	// Check if the discount percentage is within the allowed range
+	if percentTimes100 > r.MaxDiscountPercentTimes100 {
+		return 0, errors.NewLimitError(percentTimes100, r.MaxDiscountPercentTimes100, "maximum discount percentage")
+	}
+
+	// Calculate the unrounded discount as purchaseCents * percentTimes100 / 10000
+	product, err := safeint.Mul(purchaseCents, percentTimes100)
+	if err != nil {
+		return 0, err
+	}
+
+	// Round the whole quotient rather than only the remainder, so that its parity decides
+	// half-even ties
+	rounded, err := rounding.RoundInt64ToIncrement(product, percentTimes100Scale, r.RoundingMode)
+	if err != nil {
+		return 0, err
+	}
+	discountAmount := rounded / percentTimes100Scale
+
+	// Check if the discount amount exceeds the maximum allowed
+	if discountAmount > r.MaxDiscountAmount {
+		return r.MaxDiscountAmount, nil
+	}
+
+	return discountAmount, nil
+}
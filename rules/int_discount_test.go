@@ -0,0 +1,147 @@
+package rules
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	finerrors "github.com/nduyhai/finarith/errors"
+	"github.com/nduyhai/finarith/rounding"
+)
+
+func TestNewIntDiscountRule(t *testing.T) {
+	rule := NewIntDiscountRule(3000, 10000, 5000, rounding.RoundHalfUp)
+
+	if rule.MaxDiscountPercentTimes100 != 3000 {
+		t.Errorf("NewIntDiscountRule() MaxDiscountPercentTimes100 = %v, want %v", rule.MaxDiscountPercentTimes100, 3000)
+	}
+	if rule.MinPurchaseAmount != 10000 {
+		t.Errorf("NewIntDiscountRule() MinPurchaseAmount = %v, want %v", rule.MinPurchaseAmount, 10000)
+	}
+	if rule.MaxDiscountAmount != 5000 {
+		t.Errorf("NewIntDiscountRule() MaxDiscountAmount = %v, want %v", rule.MaxDiscountAmount, 5000)
+	}
+	if rule.RoundingMode != rounding.RoundHalfUp {
+		t.Errorf("NewIntDiscountRule() RoundingMode = %v, want %v", rule.RoundingMode, rounding.RoundHalfUp)
+	}
+}
+
+func TestIntDiscountRule_CalculateDiscount(t *testing.T) {
+	tests := []struct {
+		name            string
+		roundingMode    rounding.Mode
+		purchaseCents   int64
+		percentTimes100 int64
+		want            int64
+		wantErr         bool
+		errorType       error
+	}{
+		{
+			name:            "valid discount",
+			roundingMode:    rounding.RoundHalfUp,
+			purchaseCents:   20000,
+			percentTimes100: 1500,
+			want:            3000,
+		},
+		{
+			name:            "below minimum purchase amount",
+			roundingMode:    rounding.RoundHalfUp,
+			purchaseCents:   5000,
+			percentTimes100: 1500,
+			wantErr:         true,
+			errorType:       finerrors.ErrExceedsLimit,
+		},
+		{
+			name:            "above maximum discount percent",
+			roundingMode:    rounding.RoundHalfUp,
+			purchaseCents:   20000,
+			percentTimes100: 4000,
+			wantErr:         true,
+			errorType:       finerrors.ErrExceedsLimit,
+		},
+		{
+			name:            "negative discount percent",
+			roundingMode:    rounding.RoundHalfUp,
+			purchaseCents:   20000,
+			percentTimes100: -1000,
+			wantErr:         true,
+			errorType:       finerrors.ErrNegativeValue,
+		},
+		{
+			name:            "exceeds maximum discount amount",
+			roundingMode:    rounding.RoundHalfUp,
+			purchaseCents:   50000,
+			percentTimes100: 1500,
+			want:            5000,
+		},
+		{
+			name:            "fractional cent rounded half up",
+			roundingMode:    rounding.RoundHalfUp,
+			purchaseCents:   10099,
+			percentTimes100: 1250,
+			want:            1262,
+		},
+		{
+			name:            "fractional cent rounded down",
+			roundingMode:    rounding.RoundDown,
+			purchaseCents:   10099,
+			percentTimes100: 1250,
+			want:            1262,
+		},
+		{
+			name:            "fractional cent rounded up",
+			roundingMode:    rounding.RoundUp,
+			purchaseCents:   10099,
+			percentTimes100: 1250,
+			want:            1263,
+		},
+		{
+			name:            "half even tie with even quotient",
+			roundingMode:    rounding.RoundHalfEven,
+			purchaseCents:   10030,
+			percentTimes100: 1500,
+			want:            1504,
+		},
+		{
+			name:            "half even tie with odd quotient",
+			roundingMode:    rounding.RoundHalfEven,
+			purchaseCents:   10010,
+			percentTimes100: 1500,
+			want:            1502,
+		},
+		{
+			name:            "overflow",
+			roundingMode:    rounding.RoundHalfUp,
+			purchaseCents:   math.MaxInt64,
+			percentTimes100: 1500,
+			wantErr:         true,
+			errorType:       finerrors.ErrOverflow,
+		},
+		{
+			name:            "invalid rounding mode",
+			roundingMode:    rounding.Mode(99),
+			purchaseCents:   10099,
+			percentTimes100: 1250,
+			wantErr:         true,
+			errorType:       finerrors.ErrInvalidRounding,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule := NewIntDiscountRule(3000, 10000, 5000, tt.roundingMode)
+
+			got, err := rule.CalculateDiscount(tt.purchaseCents, tt.percentTimes100)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CalculateDiscount() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil && tt.errorType != nil && !errors.Is(err, tt.errorType) {
+				t.Errorf("CalculateDiscount() error type = %v, want %v", err, tt.errorType)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("CalculateDiscount() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package rules
+
+import (
+	"github.com/nduyhai/finarith/errors"
+	"github.com/nduyhai/finarith/rounding"
+	"github.com/nduyhai/finarith/safeint"
+)
+
+// IntTaxRule represents a rule for calculating taxes on amounts expressed in minor currency units (e.g. cents).
+// It mirrors TaxRule for pipelines that only work with int64 amounts.
+type IntTaxRule struct {
+	// TaxRateNumerator is the numerator of the tax rate fraction.
+	TaxRateNumerator int64
+
+	// TaxRateDenominator is the denominator of the tax rate fraction (e.g. 100 for a percentage).
+	TaxRateDenominator int64
+
+	// MinTaxableAmount is the minimum amount that is taxable, in minor units.
+	MinTaxableAmount int64
+
+	// MaxTaxAmount is the maximum tax amount that can be charged, in minor units.
+	MaxTaxAmount int64
+
+	// RoundingMode is the rounding mode to use when the tax is not a whole number of minor units.
+	RoundingMode rounding.Mode
+}
+
+// NewIntTaxRule creates a new IntTaxRule with the specified parameters.
+func NewIntTaxRule(taxRateNumerator, taxRateDenominator, minTaxableAmount, maxTaxAmount int64, roundingMode rounding.Mode) *IntTaxRule {
+	return &IntTaxRule{
+		TaxRateNumerator:   taxRateNumerator,
+		TaxRateDenominator: taxRateDenominator,
+		MinTaxableAmount:   minTaxableAmount,
+		MaxTaxAmount:       maxTaxAmount,
+		RoundingMode:       roundingMode,
+	}
+}
+
+// CalculateTax calculates the tax amount in minor units based on the taxable amount in minor units.
+// Returns an error if the rate denominator is not positive, if the calculation overflows,
+// or if the rounding mode is invalid.
+func (r *IntTaxRule) CalculateTax(taxableAmount int64) (int64, error) {
+	if r.TaxRateDenominator == 0 {
+		return 0, errors.ErrDivideByZero
+	}
+	if r.TaxRateDenominator < 0 {
+		return 0, errors.ErrNegativeValue
+	}
+
+	// Check if the amount is taxable
+	if taxableAmount < r.MinTaxableAmount {
+		return 0, nil
+	}
+
+	// Calculate the unrounded tax as taxableAmount * numerator / denominator
+	product, err := safeint.Mul(taxableAmount, r.TaxRateNumerator)
+	if err != nil {
+		return 0, err
+	}
+
+	// Round the whole quotient rather than only the remainder, so that its parity decides
+	// half-even ties
+	rounded, err := rounding.RoundInt64ToIncrement(product, r.TaxRateDenominator, r.RoundingMode)
+	if err != nil {
+		return 0, err
+	}
+	taxAmount := rounded / r.TaxRateDenominator
+
+	// Check if the tax amount exceeds the maximum allowed
+	if taxAmount > r.MaxTaxAmount {
+		return r.MaxTaxAmount, nil
+	}
+
+	return taxAmount, nil
+}
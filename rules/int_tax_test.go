@@ -0,0 +1,155 @@
+package rules
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	finerrors "github.com/nduyhai/finarith/errors"
+	"github.com/nduyhai/finarith/rounding"
+)
+
+func TestNewIntTaxRule(t *testing.T) {
+	rule := NewIntTaxRule(10, 100, 10000, 100000, rounding.RoundHalfUp)
+
+	if rule.TaxRateNumerator != 10 {
+		t.Errorf("NewIntTaxRule() TaxRateNumerator = %v, want %v", rule.TaxRateNumerator, 10)
+	}
+	if rule.TaxRateDenominator != 100 {
+		t.Errorf("NewIntTaxRule() TaxRateDenominator = %v, want %v", rule.TaxRateDenominator, 100)
+	}
+	if rule.MinTaxableAmount != 10000 {
+		t.Errorf("NewIntTaxRule() MinTaxableAmount = %v, want %v", rule.MinTaxableAmount, 10000)
+	}
+	if rule.MaxTaxAmount != 100000 {
+		t.Errorf("NewIntTaxRule() MaxTaxAmount = %v, want %v", rule.MaxTaxAmount, 100000)
+	}
+	if rule.RoundingMode != rounding.RoundHalfUp {
+		t.Errorf("NewIntTaxRule() RoundingMode = %v, want %v", rule.RoundingMode, rounding.RoundHalfUp)
+	}
+}
+
+func TestIntTaxRule_CalculateTax(t *testing.T) {
+	tests := []struct {
+		name          string
+		numerator     int64
+		denominator   int64
+		roundingMode  rounding.Mode
+		taxableAmount int64
+		want          int64
+		wantErr       bool
+		errorType     error
+	}{
+		{
+			name:          "valid tax calculation",
+			numerator:     10,
+			denominator:   100,
+			roundingMode:  rounding.RoundHalfUp,
+			taxableAmount: 50000,
+			want:          5000,
+		},
+		{
+			name:          "below minimum taxable amount",
+			numerator:     10,
+			denominator:   100,
+			roundingMode:  rounding.RoundHalfUp,
+			taxableAmount: 5000,
+			want:          0,
+		},
+		{
+			name:          "exceeds maximum tax amount",
+			numerator:     10,
+			denominator:   100,
+			roundingMode:  rounding.RoundHalfUp,
+			taxableAmount: 1500000,
+			want:          100000,
+		},
+		{
+			name:          "rounding applied",
+			numerator:     10,
+			denominator:   100,
+			roundingMode:  rounding.RoundHalfUp,
+			taxableAmount: 12345,
+			want:          1235,
+		},
+		{
+			name:          "rounding down applied",
+			numerator:     10,
+			denominator:   100,
+			roundingMode:  rounding.RoundDown,
+			taxableAmount: 12345,
+			want:          1234,
+		},
+		{
+			name:          "fractional percentage rate",
+			numerator:     825,
+			denominator:   10000,
+			roundingMode:  rounding.RoundHalfEven,
+			taxableAmount: 20000,
+			want:          1650,
+		},
+		{
+			name:          "half even tie with even quotient",
+			numerator:     10,
+			denominator:   100,
+			roundingMode:  rounding.RoundHalfEven,
+			taxableAmount: 12345,
+			want:          1234,
+		},
+		{
+			name:          "half even tie with odd quotient",
+			numerator:     10,
+			denominator:   100,
+			roundingMode:  rounding.RoundHalfEven,
+			taxableAmount: 12355,
+			want:          1236,
+		},
+		{
+			name:          "multiplication overflow",
+			numerator:     10,
+			denominator:   100,
+			roundingMode:  rounding.RoundHalfUp,
+			taxableAmount: math.MaxInt64,
+			wantErr:       true,
+			errorType:     finerrors.ErrOverflow,
+		},
+		{
+			name:          "zero denominator",
+			numerator:     10,
+			denominator:   0,
+			roundingMode:  rounding.RoundHalfUp,
+			taxableAmount: 50000,
+			wantErr:       true,
+			errorType:     finerrors.ErrDivideByZero,
+		},
+		{
+			name:          "invalid rounding mode",
+			numerator:     10,
+			denominator:   100,
+			roundingMode:  rounding.Mode(99),
+			taxableAmount: 12345,
+			wantErr:       true,
+			errorType:     finerrors.ErrInvalidRounding,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule := NewIntTaxRule(tt.numerator, tt.denominator, 10000, 100000, tt.roundingMode)
+
+			got, err := rule.CalculateTax(tt.taxableAmount)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CalculateTax() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if err != nil && tt.errorType != nil && !errors.Is(err, tt.errorType) {
+				t.Errorf("CalculateTax() error type = %v, want %v", err, tt.errorType)
+			}
+
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("CalculateTax() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package rules
+
+import (
+	"github.com/nduyhai/finarith/errors"
+	"github.com/nduyhai/finarith/rounding"
+	"github.com/nduyhai/finarith/safedec"
+)
+
+// Compounding represents how often interest is credited to the principal.
+type Compounding int
+
+// Compounding frequencies
+const (
+	// CompoundDaily credits interest 365 times a year.
+	CompoundDaily Compounding = iota
+
+	// CompoundMonthly credits interest 12 times a year.
+	CompoundMonthly
+
+	// CompoundAnnually credits interest once a year.
+	CompoundAnnually
+)
+
+// String returns the string representation of the compounding frequency.
+func (c Compounding) String() string {
+	switch c {
+	case CompoundDaily:
+		return "daily"
+	case CompoundMonthly:
+		return "monthly"
+	case CompoundAnnually:
+		return "annually"
+	default:
+		return "unknown"
+	}
+}
+
+// PeriodsPerYear returns the number of compounding periods in a year.
+// Returns ErrInvalidArgument if the compounding frequency is invalid.
+func (c Compounding) PeriodsPerYear() (int64, error) {
+	switch c {
+	case CompoundDaily:
+		return 365, nil
+	case CompoundMonthly:
+		return 12, nil
+	case CompoundAnnually:
+		return 1, nil
+	default:
+		return 0, errors.ErrInvalidArgument
+	}
+}
+
+// MaxCompoundPeriods is the largest number of periods accepted by CalculateCompound, enough for a
+// century of daily compounding. Each period is posted separately, so the cost grows with the count.
+const MaxCompoundPeriods int64 = 100 * 365
+
+// InterestRule represents a rule for calculating interest on a principal.
+type InterestRule struct {
+	// AnnualRate is the nominal annual interest rate as a percentage.
+	AnnualRate safedec.Decimal
+
+	// Compounding is how often interest is credited, which also sets the length of a period.
+	Compounding Compounding
+
+	// RoundingMode is the rounding mode to use for interest amounts.
+	RoundingMode rounding.Mode
+
+	// RoundingPrecision is the number of decimal places to round to.
+	RoundingPrecision int32
+}
+
+// NewInterestRule creates a new InterestRule with the specified parameters.
+func NewInterestRule(annualRate safedec.Decimal, compounding Compounding, roundingMode rounding.Mode, roundingPrecision int32) *InterestRule {
+	return &InterestRule{
+		AnnualRate:        annualRate,
+		Compounding:       compounding,
+		RoundingMode:      roundingMode,
+		RoundingPrecision: roundingPrecision,
+	}
+}
+
+// CalculateSimple calculates the simple interest earned on principal over the given number of
+// compounding periods, computed as principal * AnnualRate / 100 * periods / PeriodsPerYear and
+// rounded once according to the rule's rounding mode and precision.
+// Returns ErrNegativeValue if the principal or rate is negative, ErrInvalidArgument if periods is
+// negative or the compounding frequency is invalid, or an error if the rounding mode is invalid.
+func (r *InterestRule) CalculateSimple(principal safedec.Decimal, periods int64) (safedec.Decimal, error) {
+	periodsPerYear, err := r.validate(principal, periods)
+	if err != nil {
+		return safedec.Zero(), err
+	}
+
+	interest, err := principal.Mul(r.AnnualRate).Mul(safedec.NewFromInt(periods)).Div(safedec.NewFromInt(100 * periodsPerYear))
+	if err != nil {
+		return safedec.Zero(), err
+	}
+
+	return interest.Round(r.RoundingPrecision, r.RoundingMode)
+}
+
+// CalculateCompound calculates the compound interest earned on principal over the given number of
+// compounding periods. The interest for each period is rounded according to the rule's rounding
+// mode and precision before it is credited, as a ledger would post it, so the result is the sum of
+// the posted amounts and may differ slightly from principal * ((1 + rate)^periods - 1). Each
+// period's interest is rounded from its exact value, since the periodic rate is never truncated.
+// Returns ErrNegativeValue if the principal or rate is negative, ErrInvalidArgument if periods is
+// negative or the compounding frequency is invalid, an error wrapping ErrExceedsLimit if periods
+// is greater than MaxCompoundPeriods, or an error if the rounding mode is invalid.
+func (r *InterestRule) CalculateCompound(principal safedec.Decimal, periods int64) (safedec.Decimal, error) {
+	periodsPerYear, err := r.validate(principal, periods)
+	if err != nil {
+		return safedec.Zero(), err
+	}
+	if periods > MaxCompoundPeriods {
+		return safedec.Zero(), errors.NewLimitError(periods, MaxCompoundPeriods, "compounding periods")
+	}
+
+	// The interest for a period is balance * AnnualRate / divisor. Rounding the numerator to a
+	// multiple of divisor units at the rule's precision rounds the exact quotient, after which
+	// the division itself is exact
+	divisor := safedec.NewFromInt(100 * periodsPerYear)
+	increment := divisor.Shift(-r.RoundingPrecision)
+
+	// Credit the rounded interest for each period to the balance
+	balance := principal
+	for i := int64(0); i < periods; i++ {
+		scaled, err := safedec.RoundToIncrement(balance.Mul(r.AnnualRate), increment, r.RoundingMode)
+		if err != nil {
+			return safedec.Zero(), err
+		}
+		interest, err := scaled.Div(divisor)
+		if err != nil {
+			return safedec.Zero(), err
+		}
+		balance = balance.Add(interest)
+	}
+
+	return balance.Sub(principal), nil
+}
+
+// validate checks the inputs shared by the interest calculations and returns the number of
+// compounding periods in a year.
+func (r *InterestRule) validate(principal safedec.Decimal, periods int64) (int64, error) {
+	if principal.IsNegative() || r.AnnualRate.IsNegative() {
+		return 0, errors.ErrNegativeValue
+	}
+	if periods < 0 {
+		return 0, errors.ErrInvalidArgument
+	}
+	return r.Compounding.PeriodsPerYear()
+}
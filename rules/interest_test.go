@@ -0,0 +1,292 @@
+package rules
+
+import (
+	"errors"
+	"testing"
+
+	finerrors "github.com/nduyhai/finarith/errors"
+	"github.com/nduyhai/finarith/rounding"
+	"github.com/nduyhai/finarith/safedec"
+)
+
+func TestCompounding_String(t *testing.T) {
+	tests := []struct {
+		compounding Compounding
+		want        string
+	}{
+		{compounding: CompoundDaily, want: "daily"},
+		{compounding: CompoundMonthly, want: "monthly"},
+		{compounding: CompoundAnnually, want: "annually"},
+		{compounding: Compounding(99), want: "unknown"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := tt.compounding.String(); got != tt.want {
+				t.Errorf("Compounding.String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewInterestRule(t *testing.T) {
+	annualRate, _ := safedec.NewFromString("4.5")
+
+	rule := NewInterestRule(annualRate, CompoundMonthly, rounding.RoundHalfEven, 2)
+
+	if !rule.AnnualRate.Equal(annualRate) {
+		t.Errorf("NewInterestRule() AnnualRate = %v, want %v", rule.AnnualRate, annualRate)
+	}
+	if rule.Compounding != CompoundMonthly {
+		t.Errorf("NewInterestRule() Compounding = %v, want %v", rule.Compounding, CompoundMonthly)
+	}
+	if rule.RoundingMode != rounding.RoundHalfEven {
+		t.Errorf("NewInterestRule() RoundingMode = %v, want %v", rule.RoundingMode, rounding.RoundHalfEven)
+	}
+	if rule.RoundingPrecision != 2 {
+		t.Errorf("NewInterestRule() RoundingPrecision = %v, want %v", rule.RoundingPrecision, 2)
+	}
+}
+
+func TestInterestRule_CalculateSimple(t *testing.T) {
+	tests := []struct {
+		name        string
+		rate        string
+		compounding Compounding
+		principal   string
+		periods     int64
+		want        string
+		wantErr     bool
+		errorType   error
+	}{
+		{
+			name:        "one year monthly",
+			rate:        "12",
+			compounding: CompoundMonthly,
+			principal:   "1000.00",
+			periods:     12,
+			want:        "120",
+		},
+		{
+			name:        "three years annually",
+			rate:        "5",
+			compounding: CompoundAnnually,
+			principal:   "1000.00",
+			periods:     3,
+			want:        "150",
+		},
+		{
+			name:        "rounded once",
+			rate:        "3.65",
+			compounding: CompoundDaily,
+			principal:   "1234.56",
+			periods:     30,
+			want:        "3.7",
+		},
+		{
+			name:        "zero periods",
+			rate:        "5",
+			compounding: CompoundMonthly,
+			principal:   "1000.00",
+			periods:     0,
+			want:        "0",
+		},
+		{
+			name:        "negative principal",
+			rate:        "5",
+			compounding: CompoundMonthly,
+			principal:   "-1000.00",
+			periods:     12,
+			wantErr:     true,
+			errorType:   finerrors.ErrNegativeValue,
+		},
+		{
+			name:        "negative rate",
+			rate:        "-5",
+			compounding: CompoundMonthly,
+			principal:   "1000.00",
+			periods:     12,
+			wantErr:     true,
+			errorType:   finerrors.ErrNegativeValue,
+		},
+		{
+			name:        "negative periods",
+			rate:        "5",
+			compounding: CompoundMonthly,
+			principal:   "1000.00",
+			periods:     -1,
+			wantErr:     true,
+			errorType:   finerrors.ErrInvalidArgument,
+		},
+		{
+			name:        "invalid compounding",
+			rate:        "5",
+			compounding: Compounding(99),
+			principal:   "1000.00",
+			periods:     12,
+			wantErr:     true,
+			errorType:   finerrors.ErrInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rate, _ := safedec.NewFromString(tt.rate)
+			principal, _ := safedec.NewFromString(tt.principal)
+			rule := NewInterestRule(rate, tt.compounding, rounding.RoundHalfUp, 2)
+
+			got, err := rule.CalculateSimple(principal, tt.periods)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CalculateSimple() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				if !errors.Is(err, tt.errorType) {
+					t.Errorf("CalculateSimple() error type = %v, want %v", err, tt.errorType)
+				}
+				return
+			}
+			if got.String() != tt.want {
+				t.Errorf("CalculateSimple() = %v, want %v", got.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestInterestRule_CalculateCompound(t *testing.T) {
+	tests := []struct {
+		name        string
+		rate        string
+		compounding Compounding
+		principal   string
+		periods     int64
+		want        string
+		wantErr     bool
+		errorType   error
+	}{
+		{
+			name:        "one year monthly rounded each month",
+			rate:        "12",
+			compounding: CompoundMonthly,
+			principal:   "1000.00",
+			periods:     12,
+			want:        "126.84",
+		},
+		{
+			name:        "three years annually",
+			rate:        "5",
+			compounding: CompoundAnnually,
+			principal:   "1000.00",
+			periods:     3,
+			want:        "157.63",
+		},
+		{
+			name:        "daily interest below a cent of growth",
+			rate:        "3.65",
+			compounding: CompoundDaily,
+			principal:   "1000.00",
+			periods:     10,
+			want:        "1",
+		},
+		{
+			name:        "single period matches simple interest",
+			rate:        "12",
+			compounding: CompoundMonthly,
+			principal:   "1234.56",
+			periods:     1,
+			want:        "12.35",
+		},
+		{
+			name:        "periodic rate kept exact on a large balance",
+			rate:        "5",
+			compounding: CompoundDaily,
+			principal:   "10000000000000000",
+			periods:     1,
+			want:        "1369863013698.63",
+		},
+		{
+			name:        "century of daily compounding",
+			rate:        "0",
+			compounding: CompoundDaily,
+			principal:   "1000.00",
+			periods:     MaxCompoundPeriods,
+			want:        "0",
+		},
+		{
+			name:        "too many periods",
+			rate:        "5",
+			compounding: CompoundDaily,
+			principal:   "1000.00",
+			periods:     MaxCompoundPeriods + 1,
+			wantErr:     true,
+			errorType:   finerrors.ErrExceedsLimit,
+		},
+		{
+			name:        "zero periods",
+			rate:        "5",
+			compounding: CompoundMonthly,
+			principal:   "1000.00",
+			periods:     0,
+			want:        "0",
+		},
+		{
+			name:        "negative principal",
+			rate:        "5",
+			compounding: CompoundMonthly,
+			principal:   "-1000.00",
+			periods:     12,
+			wantErr:     true,
+			errorType:   finerrors.ErrNegativeValue,
+		},
+		{
+			name:        "negative rate",
+			rate:        "-5",
+			compounding: CompoundMonthly,
+			principal:   "1000.00",
+			periods:     12,
+			wantErr:     true,
+			errorType:   finerrors.ErrNegativeValue,
+		},
+		{
+			name:        "negative periods",
+			rate:        "5",
+			compounding: CompoundMonthly,
+			principal:   "1000.00",
+			periods:     -1,
+			wantErr:     true,
+			errorType:   finerrors.ErrInvalidArgument,
+		},
+		{
+			name:        "invalid compounding",
+			rate:        "5",
+			compounding: Compounding(99),
+			principal:   "1000.00",
+			periods:     12,
+			wantErr:     true,
+			errorType:   finerrors.ErrInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rate, _ := safedec.NewFromString(tt.rate)
+			principal, _ := safedec.NewFromString(tt.principal)
+			rule := NewInterestRule(rate, tt.compounding, rounding.RoundHalfUp, 2)
+
+			got, err := rule.CalculateCompound(principal, tt.periods)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CalculateCompound() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				if !errors.Is(err, tt.errorType) {
+					t.Errorf("CalculateCompound() error type = %v, want %v", err, tt.errorType)
+				}
+				return
+			}
+			if got.String() != tt.want {
+				t.Errorf("CalculateCompound() = %v, want %v", got.String(), tt.want)
+			}
+		})
+	}
+}
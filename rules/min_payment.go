@@ -0,0 +1,50 @@
+package rules
+
+import (
+	"github.com/nduyhai/finarith/rounding"
+	"github.com/nduyhai/finarith/safedec"
+)
+
+// MinPaymentRule represents a rule for calculating the minimum payment on a revolving credit balance.
+type MinPaymentRule struct {
+	// Percent is the percentage of the balance due as the minimum payment.
+	Percent safedec.Decimal
+
+	// FloorAmount is the smallest minimum payment charged, unless the balance itself is smaller.
+	FloorAmount safedec.Decimal
+
+	// RoundingMode is the rounding mode to use for the percentage of the balance.
+	RoundingMode rounding.Mode
+
+	// RoundingPrecision is the number of decimal places to round to.
+	RoundingPrecision int32
+}
+
+// NewMinPaymentRule creates a new MinPaymentRule with the specified parameters.
+func NewMinPaymentRule(percent, floorAmount safedec.Decimal, roundingMode rounding.Mode, roundingPrecision int32) *MinPaymentRule {
+	return &MinPaymentRule{
+		Percent:           percent,
+		FloorAmount:       floorAmount,
+		RoundingMode:      roundingMode,
+		RoundingPrecision: roundingPrecision,
+	}
+}
+
+// Calculate calculates the minimum payment due on the balance as the greater of the rounded
+// percentage of the balance and the floor amount, but never more than the balance itself.
+// A zero or negative (credit) balance has no minimum payment.
+// Returns an error if the rounding mode is invalid.
+func (r *MinPaymentRule) Calculate(balance safedec.Decimal) (safedec.Decimal, error) {
+	if !balance.IsPositive() {
+		return safedec.Zero(), nil
+	}
+
+	// Calculate the percentage of the balance
+	percentAmount, err := balance.PercentageUnchecked(r.Percent).Round(r.RoundingPrecision, r.RoundingMode)
+	if err != nil {
+		return safedec.Zero(), err
+	}
+
+	payment := safedec.MaxValue(percentAmount, r.FloorAmount)
+	return safedec.MinValue(payment, balance), nil
+}
@@ -0,0 +1,100 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/nduyhai/finarith/rounding"
+	"github.com/nduyhai/finarith/safedec"
+)
+
+func TestNewMinPaymentRule(t *testing.T) {
+	percent, _ := safedec.NewFromString("2.00")
+	floorAmount, _ := safedec.NewFromString("25.00")
+
+	rule := NewMinPaymentRule(percent, floorAmount, rounding.RoundHalfUp, 2)
+
+	if !rule.Percent.Equal(percent) {
+		t.Errorf("NewMinPaymentRule() Percent = %v, want %v", rule.Percent, percent)
+	}
+	if !rule.FloorAmount.Equal(floorAmount) {
+		t.Errorf("NewMinPaymentRule() FloorAmount = %v, want %v", rule.FloorAmount, floorAmount)
+	}
+	if rule.RoundingMode != rounding.RoundHalfUp {
+		t.Errorf("NewMinPaymentRule() RoundingMode = %v, want %v", rule.RoundingMode, rounding.RoundHalfUp)
+	}
+	if rule.RoundingPrecision != 2 {
+		t.Errorf("NewMinPaymentRule() RoundingPrecision = %v, want %v", rule.RoundingPrecision, 2)
+	}
+}
+
+func TestMinPaymentRule_Calculate(t *testing.T) {
+	percent, _ := safedec.NewFromString("2.00")
+	floorAmount, _ := safedec.NewFromString("25.00")
+
+	tests := []struct {
+		name    string
+		balance string
+		mode    rounding.Mode
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "percentage dominates",
+			balance: "5000.00",
+			mode:    rounding.RoundHalfUp,
+			want:    "100",
+		},
+		{
+			name:    "percentage rounded",
+			balance: "1333.33",
+			mode:    rounding.RoundHalfUp,
+			want:    "26.67",
+		},
+		{
+			name:    "floor dominates",
+			balance: "800.00",
+			mode:    rounding.RoundHalfUp,
+			want:    "25",
+		},
+		{
+			name:    "balance below floor",
+			balance: "18.40",
+			mode:    rounding.RoundHalfUp,
+			want:    "18.4",
+		},
+		{
+			name:    "zero balance",
+			balance: "0",
+			mode:    rounding.RoundHalfUp,
+			want:    "0",
+		},
+		{
+			name:    "credit balance",
+			balance: "-50.00",
+			mode:    rounding.RoundHalfUp,
+			want:    "0",
+		},
+		{
+			name:    "invalid rounding mode",
+			balance: "5000.00",
+			mode:    rounding.Mode(99),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule := NewMinPaymentRule(percent, floorAmount, tt.mode, 2)
+			balance, _ := safedec.NewFromString(tt.balance)
+
+			got, err := rule.Calculate(balance)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Calculate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && got.String() != tt.want {
+				t.Errorf("Calculate() = %v, want %v", got.String(), tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package rules
+
+import (
+	"github.com/nduyhai/finarith/errors"
+	"github.com/nduyhai/finarith/safedec"
+)
+
+// PaymentStatus classifies a payment against the amount it was meant to settle.
+type PaymentStatus int
+
+// Payment statuses
+const (
+	// PaymentExact means the payment matches the invoice amount within tolerance.
+	PaymentExact PaymentStatus = iota
+
+	// PaymentOver means the payment exceeds the invoice amount by more than the tolerance.
+	PaymentOver
+
+	// PaymentUnder means the payment falls short of the invoice amount by more than the tolerance.
+	PaymentUnder
+)
+
+// String returns the string representation of the payment status.
+func (s PaymentStatus) String() string {
+	switch s {
+	case PaymentExact:
+		return "exact"
+	case PaymentOver:
+		return "over"
+	case PaymentUnder:
+		return "under"
+	default:
+		return "unknown"
+	}
+}
+
+// ClassifyPayment compares the paid amount with the invoice amount and returns whether the invoice
+// was paid exactly, overpaid, or underpaid, together with the difference paidAmount - invoiceAmount,
+// which is positive for an overpayment and negative for an underpayment. A difference no larger
+// than tolerance in either direction counts as exact, and the difference is still reported.
+// Returns ErrNegativeValue if any of the amounts or the tolerance is negative.
+func ClassifyPayment(invoiceAmount, paidAmount, tolerance safedec.Decimal) (PaymentStatus, safedec.Decimal, error) {
+	if invoiceAmount.IsNegative() || paidAmount.IsNegative() || tolerance.IsNegative() {
+		return PaymentExact, safedec.Zero(), errors.ErrNegativeValue
+	}
+
+	difference := paidAmount.Sub(invoiceAmount)
+	switch {
+	case difference.Abs().LessThanOrEqual(tolerance):
+		return PaymentExact, difference, nil
+	case difference.IsPositive():
+		return PaymentOver, difference, nil
+	default:
+		return PaymentUnder, difference, nil
+	}
+}
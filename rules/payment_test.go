@@ -0,0 +1,130 @@
+package rules
+
+import (
+	"errors"
+	"testing"
+
+	finerrors "github.com/nduyhai/finarith/errors"
+	"github.com/nduyhai/finarith/safedec"
+)
+
+func TestPaymentStatus_String(t *testing.T) {
+	tests := []struct {
+		status PaymentStatus
+		want   string
+	}{
+		{status: PaymentExact, want: "exact"},
+		{status: PaymentOver, want: "over"},
+		{status: PaymentUnder, want: "under"},
+		{status: PaymentStatus(99), want: "unknown"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := tt.status.String(); got != tt.want {
+				t.Errorf("PaymentStatus.String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClassifyPayment(t *testing.T) {
+	tests := []struct {
+		name           string
+		invoice        string
+		paid           string
+		tolerance      string
+		wantStatus     PaymentStatus
+		wantDifference string
+		wantErr        bool
+	}{
+		{
+			name:           "exact",
+			invoice:        "125.00",
+			paid:           "125.00",
+			tolerance:      "0.05",
+			wantStatus:     PaymentExact,
+			wantDifference: "0",
+		},
+		{
+			name:           "within tolerance over",
+			invoice:        "125.00",
+			paid:           "125.03",
+			tolerance:      "0.05",
+			wantStatus:     PaymentExact,
+			wantDifference: "0.03",
+		},
+		{
+			name:           "within tolerance under at the boundary",
+			invoice:        "125.00",
+			paid:           "124.95",
+			tolerance:      "0.05",
+			wantStatus:     PaymentExact,
+			wantDifference: "-0.05",
+		},
+		{
+			name:           "overpaid",
+			invoice:        "125.00",
+			paid:           "150.00",
+			tolerance:      "0.05",
+			wantStatus:     PaymentOver,
+			wantDifference: "25",
+		},
+		{
+			name:           "underpaid",
+			invoice:        "125.00",
+			paid:           "100.50",
+			tolerance:      "0.05",
+			wantStatus:     PaymentUnder,
+			wantDifference: "-24.5",
+		},
+		{
+			name:           "zero tolerance",
+			invoice:        "125.00",
+			paid:           "125.01",
+			tolerance:      "0",
+			wantStatus:     PaymentOver,
+			wantDifference: "0.01",
+		},
+		{
+			name:      "negative tolerance",
+			invoice:   "125.00",
+			paid:      "125.00",
+			tolerance: "-0.05",
+			wantErr:   true,
+		},
+		{
+			name:      "negative payment",
+			invoice:   "125.00",
+			paid:      "-125.00",
+			tolerance: "0.05",
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			invoice, _ := safedec.NewFromString(tt.invoice)
+			paid, _ := safedec.NewFromString(tt.paid)
+			tolerance, _ := safedec.NewFromString(tt.tolerance)
+
+			status, difference, err := ClassifyPayment(invoice, paid, tolerance)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ClassifyPayment() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				if !errors.Is(err, finerrors.ErrNegativeValue) {
+					t.Errorf("ClassifyPayment() error type = %v, want %v", err, finerrors.ErrNegativeValue)
+				}
+				return
+			}
+			if status != tt.wantStatus {
+				t.Errorf("ClassifyPayment() status = %v, want %v", status, tt.wantStatus)
+			}
+			if difference.String() != tt.wantDifference {
+				t.Errorf("ClassifyPayment() difference = %v, want %v", difference.String(), tt.wantDifference)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package rules
+
+import (
+	"github.com/nduyhai/finarith/errors"
+	"github.com/nduyhai/finarith/safedec"
+)
+
+// NegativePolicy determines how a rule handles negative input values.
+type NegativePolicy int
+
+// Negative value policies
+const (
+	// NegativeReject rejects negative values with ErrNegativeValue.
+	NegativeReject NegativePolicy = iota
+
+	// NegativeAllow accepts negative values as they are.
+	NegativeAllow
+
+	// NegativeClampZero replaces negative values with zero.
+	NegativeClampZero
+)
+
+// String returns the string representation of the negative value policy.
+func (p NegativePolicy) String() string {
+	switch p {
+	case NegativeReject:
+		return "reject"
+	case NegativeAllow:
+		return "allow"
+	case NegativeClampZero:
+		return "clamp_zero"
+	default:
+		return "unknown"
+	}
+}
+
+// Apply applies the policy to a value, returning the value the rule should use.
+// Returns an error if the value is rejected by the policy or if the policy is invalid.
+func (p NegativePolicy) Apply(value safedec.Decimal) (safedec.Decimal, error) {
+	switch p {
+	case NegativeReject:
+		if value.IsNegative() {
+			return safedec.Zero(), errors.ErrNegativeValue
+		}
+		return value, nil
+	case NegativeAllow:
+		return value, nil
+	case NegativeClampZero:
+		return safedec.MaxValue(value, safedec.Zero()), nil
+	default:
+		return safedec.Zero(), errors.ErrInvalidArgument
+	}
+}
@@ -0,0 +1,203 @@
+package rules
+
+import (
+	"errors"
+	"testing"
+
+	finerrors "github.com/nduyhai/finarith/errors"
+	"github.com/nduyhai/finarith/safedec"
+)
+
+func TestNegativePolicy_String(t *testing.T) {
+	tests := []struct {
+		policy NegativePolicy
+		want   string
+	}{
+		{policy: NegativeReject, want: "reject"},
+		{policy: NegativeAllow, want: "allow"},
+		{policy: NegativeClampZero, want: "clamp_zero"},
+		{policy: NegativePolicy(99), want: "unknown"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := tt.policy.String(); got != tt.want {
+				t.Errorf("NegativePolicy.String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNegativePolicy_Apply(t *testing.T) {
+	tests := []struct {
+		name      string
+		policy    NegativePolicy
+		value     string
+		want      string
+		wantErr   bool
+		errorType error
+	}{
+		{
+			name:      "reject negative",
+			policy:    NegativeReject,
+			value:     "-5.00",
+			wantErr:   true,
+			errorType: finerrors.ErrNegativeValue,
+		},
+		{
+			name:   "reject keeps positive",
+			policy: NegativeReject,
+			value:  "5.00",
+			want:   "5",
+		},
+		{
+			name:   "allow negative",
+			policy: NegativeAllow,
+			value:  "-5.00",
+			want:   "-5",
+		},
+		{
+			name:   "clamp negative to zero",
+			policy: NegativeClampZero,
+			value:  "-5.00",
+			want:   "0",
+		},
+		{
+			name:   "clamp keeps positive",
+			policy: NegativeClampZero,
+			value:  "5.00",
+			want:   "5",
+		},
+		{
+			name:      "invalid policy",
+			policy:    NegativePolicy(99),
+			value:     "5.00",
+			wantErr:   true,
+			errorType: finerrors.ErrInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, _ := safedec.NewFromString(tt.value)
+
+			got, err := tt.policy.Apply(value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Apply() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil && !errors.Is(err, tt.errorType) {
+				t.Errorf("Apply() error type = %v, want %v", err, tt.errorType)
+			}
+			if !tt.wantErr && got.String() != tt.want {
+				t.Errorf("Apply() = %v, want %v", got.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestPricingRule_ValidatePriceNegativePolicy(t *testing.T) {
+	minPrice, _ := safedec.NewFromString("10.00")
+	maxPrice, _ := safedec.NewFromString("1000.00")
+	price, _ := safedec.NewFromString("-10.00")
+
+	tests := []struct {
+		name           string
+		policy         NegativePolicy
+		allowZeroPrice bool
+		wantErr        bool
+		errorType      error
+	}{
+		{
+			name:      "reject",
+			policy:    NegativeReject,
+			wantErr:   true,
+			errorType: finerrors.ErrNegativeValue,
+		},
+		{
+			name:    "allow",
+			policy:  NegativeAllow,
+			wantErr: false,
+		},
+		{
+			name:           "clamp to allowed zero",
+			policy:         NegativeClampZero,
+			allowZeroPrice: true,
+			wantErr:        false,
+		},
+		{
+			name:           "clamp to disallowed zero",
+			policy:         NegativeClampZero,
+			allowZeroPrice: false,
+			wantErr:        true,
+			errorType:      finerrors.ErrExceedsLimit,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule := NewPricingRuleWithPolicy(minPrice, maxPrice, tt.allowZeroPrice, tt.policy)
+
+			err := rule.ValidatePrice(price)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidatePrice() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil && !errors.Is(err, tt.errorType) {
+				t.Errorf("ValidatePrice() error type = %v, want %v", err, tt.errorType)
+			}
+		})
+	}
+}
+
+func TestDiscountRule_CalculateDiscountNegativePolicy(t *testing.T) {
+	maxDiscountPercent, _ := safedec.NewFromString("50.00")
+	minPurchaseAmount, _ := safedec.NewFromString("100.00")
+	maxDiscountAmount, _ := safedec.NewFromString("200.00")
+	purchaseAmount, _ := safedec.NewFromString("500.00")
+	discountPercent, _ := safedec.NewFromString("-10.00")
+
+	tests := []struct {
+		name      string
+		policy    NegativePolicy
+		want      string
+		wantErr   bool
+		errorType error
+	}{
+		{
+			name:      "reject",
+			policy:    NegativeReject,
+			wantErr:   true,
+			errorType: finerrors.ErrNegativeValue,
+		},
+		{
+			name:   "allow",
+			policy: NegativeAllow,
+			want:   "-50",
+		},
+		{
+			name:   "clamp to zero",
+			policy: NegativeClampZero,
+			want:   "0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule := NewDiscountRule(maxDiscountPercent, minPurchaseAmount, maxDiscountAmount)
+			rule.NegativePolicy = tt.policy
+
+			got, err := rule.CalculateDiscount(purchaseAmount, discountPercent)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CalculateDiscount() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil && !errors.Is(err, tt.errorType) {
+				t.Errorf("CalculateDiscount() error type = %v, want %v", err, tt.errorType)
+			}
+			if !tt.wantErr && got.String() != tt.want {
+				t.Errorf("CalculateDiscount() = %v, want %v", got.String(), tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package rules
+
+import (
+	"github.com/nduyhai/finarith/rounding"
+	"github.com/nduyhai/finarith/safedec"
+)
+
+// RoundingPolicy represents a rounding mode and precision that can be shared across rules,
+// so that related calculations round consistently.
+type RoundingPolicy struct {
+	// Mode is the rounding mode to use.
+	Mode rounding.Mode
+
+	// Precision is the number of decimal places to round to.
+	Precision int32
+}
+
+// Round rounds the value according to the policy.
+// Returns an error if the rounding mode is invalid.
+func (p RoundingPolicy) Round(d safedec.Decimal) (safedec.Decimal, error) {
+	return d.Round(p.Precision, p.Mode)
+}
+
+// NewTaxRuleWithRounding creates a new TaxRule that rounds according to the policy.
+func NewTaxRuleWithRounding(taxRate, minTaxableAmount, maxTaxAmount safedec.Decimal, policy RoundingPolicy) *TaxRule {
+	return NewTaxRule(taxRate, minTaxableAmount, maxTaxAmount, policy.Mode, policy.Precision)
+}
+
+// NewDiscountRuleWithRounding creates a new DiscountRule that rounds discount amounts according to the policy.
+func NewDiscountRuleWithRounding(maxDiscountPercent, minPurchaseAmount, maxDiscountAmount safedec.Decimal, policy RoundingPolicy) *DiscountRule {
+	r := NewDiscountRule(maxDiscountPercent, minPurchaseAmount, maxDiscountAmount)
+	r.Rounding = &policy
+	return r
+}
+
+// NewFeeRuleWithRounding creates a new FeeRule that rounds according to the policy.
+func NewFeeRuleWithRounding(percentRate, fixedFee safedec.Decimal, policy RoundingPolicy) *FeeRule {
+	return NewFeeRule(percentRate, fixedFee, policy.Mode, policy.Precision)
+}
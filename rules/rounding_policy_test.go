@@ -0,0 +1,117 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/nduyhai/finarith/rounding"
+	"github.com/nduyhai/finarith/safedec"
+)
+
+func TestRoundingPolicy_Round(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  RoundingPolicy
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:   "half up to cents",
+			policy: RoundingPolicy{Mode: rounding.RoundHalfUp, Precision: 2},
+			value:  "1.005",
+			want:   "1.01",
+		},
+		{
+			name:   "half even to cents",
+			policy: RoundingPolicy{Mode: rounding.RoundHalfEven, Precision: 2},
+			value:  "1.005",
+			want:   "1",
+		},
+		{
+			name:   "floor to whole units",
+			policy: RoundingPolicy{Mode: rounding.RoundFloor, Precision: 0},
+			value:  "-1.5",
+			want:   "-2",
+		},
+		{
+			name:    "invalid rounding mode",
+			policy:  RoundingPolicy{Mode: rounding.Mode(99), Precision: 2},
+			value:   "1.005",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, _ := safedec.NewFromString(tt.value)
+			got, err := tt.policy.Round(value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Round() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && got.String() != tt.want {
+				t.Errorf("Round() = %v, want %v", got.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestRulesWithSharedRoundingPolicy(t *testing.T) {
+	policy := RoundingPolicy{Mode: rounding.RoundDown, Precision: 2}
+
+	rate, _ := safedec.NewFromString("7.5")
+	minAmount := safedec.Zero()
+	maxAmount, _ := safedec.NewFromString("1000")
+	fixedFee := safedec.Zero()
+
+	taxRule := NewTaxRuleWithRounding(rate, minAmount, maxAmount, policy)
+	discountRule := NewDiscountRuleWithRounding(safedec.NewFromInt(100), minAmount, maxAmount, policy)
+	feeRule := NewFeeRuleWithRounding(rate, fixedFee, policy)
+
+	if taxRule.RoundingMode != policy.Mode || taxRule.RoundingPrecision != policy.Precision {
+		t.Errorf("NewTaxRuleWithRounding() rounding = %v/%v, want %v/%v", taxRule.RoundingMode, taxRule.RoundingPrecision, policy.Mode, policy.Precision)
+	}
+	if feeRule.RoundingMode != policy.Mode || feeRule.RoundingPrecision != policy.Precision {
+		t.Errorf("NewFeeRuleWithRounding() rounding = %v/%v, want %v/%v", feeRule.RoundingMode, feeRule.RoundingPrecision, policy.Mode, policy.Precision)
+	}
+	if discountRule.Rounding == nil || *discountRule.Rounding != policy {
+		t.Errorf("NewDiscountRuleWithRounding() Rounding = %v, want %v", discountRule.Rounding, policy)
+	}
+
+	// 7.5% of 10.99 is 0.82425, which every rule must round down to 0.82
+	amount, _ := safedec.NewFromString("10.99")
+	want := "0.82"
+
+	tax, err := taxRule.CalculateTax(amount)
+	if err != nil {
+		t.Fatalf("CalculateTax() error = %v", err)
+	}
+	discount, err := discountRule.CalculateDiscount(amount, rate)
+	if err != nil {
+		t.Fatalf("CalculateDiscount() error = %v", err)
+	}
+	fee, err := feeRule.CalculateFee(amount)
+	if err != nil {
+		t.Fatalf("CalculateFee() error = %v", err)
+	}
+
+	for name, got := range map[string]safedec.Decimal{"CalculateTax": tax, "CalculateDiscount": discount, "CalculateFee": fee} {
+		if got.String() != want {
+			t.Errorf("%s() = %v, want %v", name, got.String(), want)
+		}
+	}
+}
+
+func TestDiscountRule_CalculateDiscountWithoutRounding(t *testing.T) {
+	rule := NewDiscountRule(safedec.NewFromInt(100), safedec.Zero(), safedec.NewFromInt(1000))
+	amount, _ := safedec.NewFromString("10.99")
+	rate, _ := safedec.NewFromString("7.5")
+
+	got, err := rule.CalculateDiscount(amount, rate)
+	if err != nil {
+		t.Fatalf("CalculateDiscount() error = %v", err)
+	}
+	if got.String() != "0.82425" {
+		t.Errorf("CalculateDiscount() = %v, want 0.82425", got.String())
+	}
+}
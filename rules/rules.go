@@ -2,6 +2,12 @@
 package rules
 
 import (
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+
+	"github.com/nduyhai/finarith/currency"
 	"github.com/nduyhai/finarith/errors"
 	"github.com/nduyhai/finarith/rounding"
 	"github.com/nduyhai/finarith/safedec"
@@ -35,6 +41,24 @@ func NewTransferRule(maxAmount, minAmount, dailyLimit safedec.Decimal, allowNega
 // ValidateTransfer validates a transfer against the rule.
 // Returns an error if the transfer violates any of the rules.
 func (r *TransferRule) ValidateTransfer(amount, sourceBalance, dailyTotal safedec.Decimal) error {
+	if err := r.validateLimits(amount, dailyTotal); err != nil {
+		return err
+	}
+
+	// Check if the source account has sufficient balance
+	if !r.AllowNegativeBalance {
+		newBalance, err := sourceBalance.SubNonNegative(amount)
+		if err != nil {
+			return errors.NewLimitError(amount.String(), sourceBalance.String(), "available balance")
+		}
+		_ = newBalance // Avoid unused variable warning
+	}
+
+	return nil
+}
+
+// validateLimits checks the amount against the minimum, maximum, and daily transfer limits.
+func (r *TransferRule) validateLimits(amount, dailyTotal safedec.Decimal) error {
 	// Check if the amount is within the allowed range
 	if amount.LessThan(r.MinAmount) {
 		return errors.NewLimitError(amount.String(), r.MinAmount.String(), "minimum transfer")
@@ -50,20 +74,18 @@ func (r *TransferRule) ValidateTransfer(amount, sourceBalance, dailyTotal safede
 		return errors.NewLimitError(newDailyTotal.String(), r.DailyLimit.String(), "daily transfer")
 	}
 
-	// Check if the source account has sufficient balance
-	if !r.AllowNegativeBalance {
-		newBalance, err := sourceBalance.SubNonNegative(amount)
-		if err != nil {
-			return errors.NewLimitError(amount.String(), sourceBalance.String(), "available balance")
-		}
-		_ = newBalance // Avoid unused variable warning
-	}
-
 	return nil
 }
 
 // PricingRule represents a rule for pricing calculations.
+// ValidatePrice may be called concurrently with BulkUpdateLimits; it always observes
+// either all or none of a bulk update. Direct assignments to the fields are not
+// synchronized and must not race with either method.
+// A PricingRule contains a mutex and must not be copied after first use; share it by pointer,
+// as returned by NewPricingRule.
 type PricingRule struct {
+	mu sync.RWMutex
+
 	// MinPrice is the minimum price allowed.
 	MinPrice safedec.Decimal
 
@@ -74,7 +96,11 @@ type PricingRule struct {
 	AllowZeroPrice bool
 
 	// AllowNegativePrice determines if a negative price is allowed.
+	// When true it takes precedence over NegativePolicy, as if NegativePolicy were NegativeAllow.
 	AllowNegativePrice bool
+
+	// NegativePolicy determines how negative prices are handled.
+	NegativePolicy NegativePolicy
 }
 
 // NewPricingRule creates a new PricingRule with the specified constraints.
@@ -87,19 +113,41 @@ func NewPricingRule(minPrice, maxPrice safedec.Decimal, allowZeroPrice, allowNeg
 	}
 }
 
+// NewPricingRuleWithPolicy creates a new PricingRule that handles negative prices according to the policy.
+func NewPricingRuleWithPolicy(minPrice, maxPrice safedec.Decimal, allowZeroPrice bool, negativePolicy NegativePolicy) *PricingRule {
+	return &PricingRule{
+		MinPrice:       minPrice,
+		MaxPrice:       maxPrice,
+		AllowZeroPrice: allowZeroPrice,
+		NegativePolicy: negativePolicy,
+	}
+}
+
+// negativePolicy returns the effective negative value policy of the rule.
+func (r *PricingRule) negativePolicy() NegativePolicy {
+	if r.AllowNegativePrice {
+		return NegativeAllow
+	}
+	return r.NegativePolicy
+}
+
 // ValidatePrice validates a price against the rule.
 // Returns an error if the price violates any of the rules.
 func (r *PricingRule) ValidatePrice(price safedec.Decimal) error {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	// Apply the negative value policy; a clamped price is then validated as zero
+	price, err := r.negativePolicy().Apply(price)
+	if err != nil {
+		return err
+	}
+
 	// Check if zero prices are allowed
 	if price.IsZero() && !r.AllowZeroPrice {
 		return errors.NewLimitError("0", r.MinPrice.String(), "minimum price")
 	}
 
-	// Check if a negative price is allowed
-	if price.IsNegative() && !r.AllowNegativePrice {
-		return errors.ErrNegativeValue
-	}
-
 	// Check if the price is within the allowed range
 	if !price.IsZero() && !price.IsNegative() && price.LessThan(r.MinPrice) {
 		return errors.NewLimitError(price.String(), r.MinPrice.String(), "minimum price")
@@ -112,6 +160,56 @@ func (r *PricingRule) ValidatePrice(price safedec.Decimal) error {
 	return nil
 }
 
+// Pricing rule limit keys accepted by BulkUpdateLimits.
+const (
+	LimitMinPrice = "min_price"
+	LimitMaxPrice = "max_price"
+)
+
+// BulkUpdateLimits applies several limit updates to the rule atomically.
+// The updates map is keyed by LimitMinPrice and LimitMaxPrice; a PricingRule has no set of
+// allowed values, so other keys, including "allowed_values", are rejected. Either all updates are
+// applied or, if any of them is invalid, none are and a MultiError describing every problem is
+// returned.
+func (r *PricingRule) BulkUpdateLimits(updates map[string]safedec.Decimal) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	minPrice := r.MinPrice
+	maxPrice := r.MaxPrice
+
+	var errs []error
+	for _, key := range slices.Sorted(maps.Keys(updates)) {
+		value := updates[key]
+		if value.IsNegative() && r.negativePolicy() != NegativeAllow {
+			errs = append(errs, fmt.Errorf("%s: %w", key, errors.ErrNegativeValue))
+			continue
+		}
+
+		switch key {
+		case LimitMinPrice:
+			minPrice = value
+		case LimitMaxPrice:
+			maxPrice = value
+		default:
+			errs = append(errs, fmt.Errorf("unknown pricing rule limit %q", key))
+		}
+	}
+
+	// Only check consistency when every value is individually valid
+	if len(errs) == 0 && minPrice.GreaterThan(maxPrice) {
+		errs = append(errs, errors.NewLimitError(minPrice.String(), maxPrice.String(), "maximum price"))
+	}
+
+	if len(errs) > 0 {
+		return errors.NewMultiError(errs...)
+	}
+
+	r.MinPrice = minPrice
+	r.MaxPrice = maxPrice
+	return nil
+}
+
 // DiscountRule represents a rule for applying discounts.
 type DiscountRule struct {
 	// MaxDiscountPercent is the maximum discount percentage allowed.
@@ -122,6 +220,13 @@ type DiscountRule struct {
 
 	// MaxDiscountAmount is the maximum absolute discount amount allowed.
 	MaxDiscountAmount safedec.Decimal
+
+	// NegativePolicy determines how negative discount percentages are handled.
+	NegativePolicy NegativePolicy
+
+	// Rounding, if set, rounds the discount amount before MaxDiscountAmount is applied.
+	// Nil leaves the discount amount unrounded.
+	Rounding *RoundingPolicy
 }
 
 // NewDiscountRule creates a new DiscountRule with the specified constraints.
@@ -141,20 +246,25 @@ func (r *DiscountRule) CalculateDiscount(purchaseAmount, discountPercent safedec
 		return safedec.Zero(), errors.NewLimitError(purchaseAmount.String(), r.MinPurchaseAmount.String(), "minimum purchase for discount")
 	}
 
-	// Check if the discount percentage is within the allowed range
-	if discountPercent.IsNegative() {
-		return safedec.Zero(), errors.ErrNegativeValue
+	// Apply the negative value policy to the discount percentage
+	discountPercent, err := r.NegativePolicy.Apply(discountPercent)
+	if err != nil {
+		return safedec.Zero(), err
 	}
 
+	// Check if the discount percentage is within the allowed range
 	if discountPercent.GreaterThan(r.MaxDiscountPercent) {
 		return safedec.Zero(), errors.NewLimitError(discountPercent.String(), r.MaxDiscountPercent.String(), "maximum discount percentage")
 	}
 
 	// Calculate the discount amount
-	hundred := safedec.NewFromInt(100)
-	discountAmount, err := purchaseAmount.Mul(discountPercent).Div(hundred)
-	if err != nil {
-		return safedec.Zero(), err
+	discountAmount := purchaseAmount.PercentageUnchecked(discountPercent)
+
+	if r.Rounding != nil {
+		discountAmount, err = r.Rounding.Round(discountAmount)
+		if err != nil {
+			return safedec.Zero(), err
+		}
 	}
 
 	// Check if the discount amount exceeds the maximum allowed
@@ -194,6 +304,17 @@ func NewTaxRule(taxRate, minTaxableAmount, maxTaxAmount safedec.Decimal, roundin
 	}
 }
 
+// NewTaxRuleForCurrency creates a new TaxRule whose rounding precision is the minor unit of the
+// given ISO 4217 currency, so tax amounts are always settleable in that currency.
+// Returns an error if the currency is not recognized.
+func NewTaxRuleForCurrency(taxRate, minTaxableAmount, maxTaxAmount safedec.Decimal, currencyCode string, roundingMode rounding.Mode) (*TaxRule, error) {
+	precision, err := currency.MinorUnit(currencyCode)
+	if err != nil {
+		return nil, err
+	}
+	return NewTaxRule(taxRate, minTaxableAmount, maxTaxAmount, roundingMode, precision), nil
+}
+
 // CalculateTax calculates the tax amount based on the taxable amount.
 // Returns an error if the tax calculation violates any of the rules.
 func (r *TaxRule) CalculateTax(taxableAmount safedec.Decimal) (safedec.Decimal, error) {
@@ -203,14 +324,10 @@ func (r *TaxRule) CalculateTax(taxableAmount safedec.Decimal) (safedec.Decimal,
 	}
 
 	// Calculate the tax amount
-	hundred := safedec.NewFromInt(100)
-	taxAmount, err := taxableAmount.Mul(r.TaxRate).Div(hundred)
-	if err != nil {
-		return safedec.Zero(), err
-	}
+	taxAmount := taxableAmount.PercentageUnchecked(r.TaxRate)
 
 	// Round the tax amount according to the specified rounding mode and precision
-	taxAmount, err = taxAmount.Round(r.RoundingPrecision, r.RoundingMode)
+	taxAmount, err := taxAmount.Round(r.RoundingPrecision, r.RoundingMode)
 	if err != nil {
 		return safedec.Zero(), err
 	}
@@ -2,6 +2,7 @@ package rules
 
 import (
 	"errors"
+	"sync"
 	"testing"
 
 	finerrors "github.com/nduyhai/finarith/errors"
@@ -349,6 +350,61 @@ func TestNewTaxRule(t *testing.T) {
 	}
 }
 
+func TestNewTaxRuleForCurrency(t *testing.T) {
+	taxRate, _ := safedec.NewFromString("10.00")
+	minTaxableAmount, _ := safedec.NewFromString("100.00")
+	maxTaxAmount, _ := safedec.NewFromString("1000.00")
+
+	tests := []struct {
+		name          string
+		currency      string
+		wantPrecision int32
+		wantErr       bool
+	}{
+		{
+			name:          "US dollar",
+			currency:      "USD",
+			wantPrecision: 2,
+		},
+		{
+			name:          "Japanese yen",
+			currency:      "JPY",
+			wantPrecision: 0,
+		},
+		{
+			name:     "unknown currency",
+			currency: "XYZ",
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := NewTaxRuleForCurrency(taxRate, minTaxableAmount, maxTaxAmount, tt.currency, rounding.RoundHalfUp)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewTaxRuleForCurrency() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				if !errors.Is(err, finerrors.ErrUnknownCurrency) {
+					t.Errorf("NewTaxRuleForCurrency() error type = %v, want %v", err, finerrors.ErrUnknownCurrency)
+				}
+				return
+			}
+
+			if rule.RoundingPrecision != tt.wantPrecision {
+				t.Errorf("NewTaxRuleForCurrency() RoundingPrecision = %v, want %v", rule.RoundingPrecision, tt.wantPrecision)
+			}
+			if rule.RoundingMode != rounding.RoundHalfUp {
+				t.Errorf("NewTaxRuleForCurrency() RoundingMode = %v, want %v", rule.RoundingMode, rounding.RoundHalfUp)
+			}
+			if !rule.TaxRate.Equal(taxRate) {
+				t.Errorf("NewTaxRuleForCurrency() TaxRate = %v, want %v", rule.TaxRate, taxRate)
+			}
+		})
+	}
+}
+
 func TestTaxRule_CalculateTax(t *testing.T) {
 	taxRate, _ := safedec.NewFromString("10.00")
 	minTaxableAmount, _ := safedec.NewFromString("100.00")
@@ -406,3 +462,120 @@ func TestTaxRule_CalculateTax(t *testing.T) {
 		})
 	}
 }
+
+func TestPricingRule_BulkUpdateLimits(t *testing.T) {
+	minPrice, _ := safedec.NewFromString("10.00")
+	maxPrice, _ := safedec.NewFromString("1000.00")
+
+	tests := []struct {
+		name      string
+		updates   map[string]string
+		wantMin   string
+		wantMax   string
+		wantErr   bool
+		errorType error
+	}{
+		{
+			name:    "update both limits",
+			updates: map[string]string{LimitMinPrice: "2000.00", LimitMaxPrice: "5000.00"},
+			wantMin: "2000",
+			wantMax: "5000",
+		},
+		{
+			name:    "update max only",
+			updates: map[string]string{LimitMaxPrice: "50.00"},
+			wantMin: "10",
+			wantMax: "50",
+		},
+		{
+			name:      "min greater than max",
+			updates:   map[string]string{LimitMinPrice: "20.00", LimitMaxPrice: "15.00"},
+			wantMin:   "10",
+			wantMax:   "1000",
+			wantErr:   true,
+			errorType: finerrors.ErrExceedsLimit,
+		},
+		{
+			name:      "negative value rejected",
+			updates:   map[string]string{LimitMinPrice: "-1.00", LimitMaxPrice: "500.00"},
+			wantMin:   "10",
+			wantMax:   "1000",
+			wantErr:   true,
+			errorType: finerrors.ErrNegativeValue,
+		},
+		{
+			name:    "unknown key rejected",
+			updates: map[string]string{"allowed_values": "1.00", LimitMaxPrice: "500.00"},
+			wantMin: "10",
+			wantMax: "1000",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule := NewPricingRule(minPrice, maxPrice, false, false)
+
+			updates := make(map[string]safedec.Decimal, len(tt.updates))
+			for key, value := range tt.updates {
+				updates[key], _ = safedec.NewFromString(value)
+			}
+
+			err := rule.BulkUpdateLimits(updates)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("BulkUpdateLimits() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			var multiErr *finerrors.MultiError
+			if err != nil && !errors.As(err, &multiErr) {
+				t.Errorf("BulkUpdateLimits() error is not a MultiError: %v", err)
+			}
+
+			if err != nil && tt.errorType != nil && !errors.Is(err, tt.errorType) {
+				t.Errorf("BulkUpdateLimits() error type = %v, want %v", err, tt.errorType)
+			}
+
+			if rule.MinPrice.String() != tt.wantMin {
+				t.Errorf("BulkUpdateLimits() MinPrice = %v, want %v", rule.MinPrice, tt.wantMin)
+			}
+			if rule.MaxPrice.String() != tt.wantMax {
+				t.Errorf("BulkUpdateLimits() MaxPrice = %v, want %v", rule.MaxPrice, tt.wantMax)
+			}
+		})
+	}
+}
+
+func TestPricingRule_BulkUpdateLimitsConcurrent(t *testing.T) {
+	minPrice, _ := safedec.NewFromString("10.00")
+	maxPrice, _ := safedec.NewFromString("20.00")
+	rule := NewPricingRule(minPrice, maxPrice, false, false)
+
+	low := map[string]safedec.Decimal{LimitMinPrice: minPrice, LimitMaxPrice: maxPrice}
+	high := map[string]safedec.Decimal{LimitMinPrice: safedec.NewFromInt(100), LimitMaxPrice: safedec.NewFromInt(200)}
+	price := safedec.NewFromInt(50)
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 1000; i++ {
+			if i%2 == 0 {
+				_ = rule.BulkUpdateLimits(high)
+			} else {
+				_ = rule.BulkUpdateLimits(low)
+			}
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 1000; i++ {
+			// 50 is outside both limit pairs, so it must always be rejected
+			if err := rule.ValidatePrice(price); err == nil {
+				t.Errorf("ValidatePrice() observed an inconsistent rule")
+				return
+			}
+		}
+	}()
+	wg.Wait()
+}
@@ -0,0 +1,102 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/nduyhai/finarith/errors"
+	"github.com/nduyhai/finarith/safedec"
+)
+
+// SlippageDirection represents which price movements a SlippageRule treats as adverse.
+type SlippageDirection int
+
+// Slippage directions
+const (
+	// BidSide only checks executions below the expected price.
+	BidSide SlippageDirection = iota
+
+	// AskSide only checks executions above the expected price.
+	AskSide
+
+	// Both checks executions on either side of the expected price.
+	Both
+)
+
+// String returns the string representation of the slippage direction.
+func (s SlippageDirection) String() string {
+	switch s {
+	case BidSide:
+		return "bid_side"
+	case AskSide:
+		return "ask_side"
+	case Both:
+		return "both"
+	default:
+		return "unknown"
+	}
+}
+
+// SlippageRule represents a rule for validating an execution price against the expected price.
+type SlippageRule struct {
+	// MaxSlippageBps is the maximum slippage allowed, in basis points of the expected price.
+	MaxSlippageBps int64
+
+	// SlippageDirection determines which side of the expected price is checked.
+	SlippageDirection SlippageDirection
+}
+
+// NewSlippageRule creates a new SlippageRule with the specified limit and direction.
+func NewSlippageRule(maxSlippageBps int64, direction SlippageDirection) *SlippageRule {
+	return &SlippageRule{
+		MaxSlippageBps:    maxSlippageBps,
+		SlippageDirection: direction,
+	}
+}
+
+// ValidateExecution validates the executed price against the expected price.
+// Returns an error if the adverse slippage exceeds MaxSlippageBps, if the expected price is zero,
+// or if the direction is invalid.
+func (r *SlippageRule) ValidateExecution(expectedPrice, executedPrice safedec.Decimal) error {
+	slippage, err := slippageBps(expectedPrice, executedPrice)
+	if err != nil {
+		return err
+	}
+
+	// Only the adverse side of the movement counts for one-sided rules
+	var adverse safedec.Decimal
+	switch r.SlippageDirection {
+	case BidSide:
+		adverse = slippage.Neg()
+	case AskSide:
+		adverse = slippage
+	case Both:
+		adverse = slippage.Abs()
+	default:
+		return fmt.Errorf("%w: invalid slippage direction %d", errors.ErrInvalidArgument, r.SlippageDirection)
+	}
+
+	maxSlippage := safedec.NewFromInt(r.MaxSlippageBps)
+	if adverse.GreaterThan(maxSlippage) {
+		return errors.NewDeviationError(slippage.String()+"bps", maxSlippage.String()+"bps", r.SlippageDirection.String()+" slippage")
+	}
+
+	return nil
+}
+
+// CalculateSlippageBps calculates the signed slippage of the executed price from the expected price
+// in basis points, rounded to the nearest basis point. The result is positive when the executed
+// price is above the expected price.
+// Returns an error if the expected price is zero.
+func CalculateSlippageBps(expected, executed safedec.Decimal) (int64, error) {
+	slippage, err := slippageBps(expected, executed)
+	if err != nil {
+		return 0, err
+	}
+	return slippage.Value().Round(0).IntPart(), nil
+}
+
+// slippageBps returns the exact signed slippage in basis points.
+func slippageBps(expected, executed safedec.Decimal) (safedec.Decimal, error) {
+	bps := safedec.NewFromInt(10000)
+	return executed.Sub(expected).Mul(bps).Div(expected.Abs())
+}
@@ -0,0 +1,206 @@
+package rules
+
+import (
+	"errors"
+	"testing"
+
+	finerrors "github.com/nduyhai/finarith/errors"
+	"github.com/nduyhai/finarith/safedec"
+)
+
+func TestSlippageDirection_String(t *testing.T) {
+	tests := []struct {
+		direction SlippageDirection
+		want      string
+	}{
+		{direction: BidSide, want: "bid_side"},
+		{direction: AskSide, want: "ask_side"},
+		{direction: Both, want: "both"},
+		{direction: SlippageDirection(99), want: "unknown"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := tt.direction.String(); got != tt.want {
+				t.Errorf("SlippageDirection.String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewSlippageRule(t *testing.T) {
+	rule := NewSlippageRule(25, AskSide)
+
+	if rule.MaxSlippageBps != 25 {
+		t.Errorf("NewSlippageRule() MaxSlippageBps = %v, want %v", rule.MaxSlippageBps, 25)
+	}
+	if rule.SlippageDirection != AskSide {
+		t.Errorf("NewSlippageRule() SlippageDirection = %v, want %v", rule.SlippageDirection, AskSide)
+	}
+}
+
+func TestSlippageRule_ValidateExecution(t *testing.T) {
+	tests := []struct {
+		name          string
+		maxBps        int64
+		direction     SlippageDirection
+		expectedPrice string
+		executedPrice string
+		wantErr       bool
+		errorType     error
+	}{
+		{
+			name:          "buy filled within slippage",
+			maxBps:        10,
+			direction:     AskSide,
+			expectedPrice: "100.00",
+			executedPrice: "100.10",
+			wantErr:       false,
+		},
+		{
+			name:          "buy filled above slippage",
+			maxBps:        10,
+			direction:     AskSide,
+			expectedPrice: "100.00",
+			executedPrice: "100.11",
+			wantErr:       true,
+			errorType:     finerrors.ErrRateDeviation,
+		},
+		{
+			name:          "buy filled at a better price",
+			maxBps:        10,
+			direction:     AskSide,
+			expectedPrice: "100.00",
+			executedPrice: "95.00",
+			wantErr:       false,
+		},
+		{
+			name:          "sell filled below slippage",
+			maxBps:        50,
+			direction:     BidSide,
+			expectedPrice: "2000.00",
+			executedPrice: "1989.00",
+			wantErr:       true,
+			errorType:     finerrors.ErrRateDeviation,
+		},
+		{
+			name:          "sell filled at a better price",
+			maxBps:        50,
+			direction:     BidSide,
+			expectedPrice: "2000.00",
+			executedPrice: "2100.00",
+			wantErr:       false,
+		},
+		{
+			name:          "both sides within slippage",
+			maxBps:        5,
+			direction:     Both,
+			expectedPrice: "1.2500",
+			executedPrice: "1.2494",
+			wantErr:       false,
+		},
+		{
+			name:          "both sides above slippage",
+			maxBps:        5,
+			direction:     Both,
+			expectedPrice: "1.2500",
+			executedPrice: "1.2507",
+			wantErr:       true,
+			errorType:     finerrors.ErrRateDeviation,
+		},
+		{
+			name:          "zero expected price",
+			maxBps:        5,
+			direction:     Both,
+			expectedPrice: "0",
+			executedPrice: "1.00",
+			wantErr:       true,
+			errorType:     finerrors.ErrDivideByZero,
+		},
+		{
+			name:          "invalid direction",
+			maxBps:        5,
+			direction:     SlippageDirection(99),
+			expectedPrice: "1.00",
+			executedPrice: "1.00",
+			wantErr:       true,
+			errorType:     finerrors.ErrInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule := NewSlippageRule(tt.maxBps, tt.direction)
+
+			expectedPrice, _ := safedec.NewFromString(tt.expectedPrice)
+			executedPrice, _ := safedec.NewFromString(tt.executedPrice)
+
+			err := rule.ValidateExecution(expectedPrice, executedPrice)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateExecution() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if err != nil && tt.errorType != nil && !errors.Is(err, tt.errorType) {
+				t.Errorf("ValidateExecution() error type = %v, want %v", err, tt.errorType)
+			}
+		})
+	}
+}
+
+func TestCalculateSlippageBps(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected string
+		executed string
+		want     int64
+		wantErr  bool
+	}{
+		{
+			name:     "executed above expected",
+			expected: "100.00",
+			executed: "100.25",
+			want:     25,
+		},
+		{
+			name:     "executed below expected",
+			expected: "2000.00",
+			executed: "1990.00",
+			want:     -50,
+		},
+		{
+			name:     "rounded to nearest basis point",
+			expected: "3.00",
+			executed: "3.001",
+			want:     3,
+		},
+		{
+			name:     "no slippage",
+			expected: "42.00",
+			executed: "42.00",
+			want:     0,
+		},
+		{
+			name:     "zero expected price",
+			expected: "0",
+			executed: "42.00",
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expected, _ := safedec.NewFromString(tt.expected)
+			executed, _ := safedec.NewFromString(tt.executed)
+
+			got, err := CalculateSlippageBps(expected, executed)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CalculateSlippageBps() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("CalculateSlippageBps() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
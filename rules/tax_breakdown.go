@@ -0,0 +1,30 @@
+package rules
+
+import (
+	"github.com/nduyhai/finarith/errors"
+	"github.com/nduyhai/finarith/safedec"
+)
+
+// LineBreakdown splits a receipt line into its net, tax, and gross amounts. The net amount is
+// rounded to the rule's precision with the rule's rounding mode, the tax is computed on the
+// rounded net with CalculateTax, and the gross is their sum, so net + tax == gross exactly.
+// A line below the minimum taxable amount carries zero tax and a gross equal to its net.
+// Returns ErrNegativeValue if the line net amount is negative, or an error if the rounding
+// mode is invalid.
+func (r *TaxRule) LineBreakdown(lineNet safedec.Decimal) (net, tax, gross safedec.Decimal, err error) {
+	if lineNet.IsNegative() {
+		return safedec.Zero(), safedec.Zero(), safedec.Zero(), errors.ErrNegativeValue
+	}
+
+	net, err = lineNet.Round(r.RoundingPrecision, r.RoundingMode)
+	if err != nil {
+		return safedec.Zero(), safedec.Zero(), safedec.Zero(), err
+	}
+
+	tax, err = r.CalculateTax(net)
+	if err != nil {
+		return safedec.Zero(), safedec.Zero(), safedec.Zero(), err
+	}
+
+	return net, tax, net.Add(tax), nil
+}
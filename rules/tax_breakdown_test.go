@@ -0,0 +1,92 @@
+package rules
+
+import (
+	"errors"
+	"testing"
+
+	finerrors "github.com/nduyhai/finarith/errors"
+	"github.com/nduyhai/finarith/rounding"
+	"github.com/nduyhai/finarith/safedec"
+)
+
+func TestTaxRule_LineBreakdown(t *testing.T) {
+	taxRate, _ := safedec.NewFromString("8.25")
+	minTaxableAmount, _ := safedec.NewFromString("10.00")
+	maxTaxAmount, _ := safedec.NewFromString("100.00")
+	rule := NewTaxRule(taxRate, minTaxableAmount, maxTaxAmount, rounding.RoundHalfUp, 2)
+
+	tests := []struct {
+		name      string
+		lineNet   string
+		wantNet   string
+		wantTax   string
+		wantGross string
+		wantErr   bool
+		errorType error
+	}{
+		{
+			name:      "taxable line",
+			lineNet:   "123.45",
+			wantNet:   "123.45",
+			wantTax:   "10.18",
+			wantGross: "133.63",
+		},
+		{
+			name:      "net rounded before tax",
+			lineNet:   "19.995",
+			wantNet:   "20",
+			wantTax:   "1.65",
+			wantGross: "21.65",
+		},
+		{
+			name:      "below threshold line",
+			lineNet:   "9.99",
+			wantNet:   "9.99",
+			wantTax:   "0",
+			wantGross: "9.99",
+		},
+		{
+			name:      "tax capped at maximum",
+			lineNet:   "5000.00",
+			wantNet:   "5000",
+			wantTax:   "100",
+			wantGross: "5100",
+		},
+		{
+			name:      "negative line",
+			lineNet:   "-1.00",
+			wantErr:   true,
+			errorType: finerrors.ErrNegativeValue,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lineNet, _ := safedec.NewFromString(tt.lineNet)
+
+			net, tax, gross, err := rule.LineBreakdown(lineNet)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("LineBreakdown() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				if tt.errorType != nil && !errors.Is(err, tt.errorType) {
+					t.Errorf("LineBreakdown() error = %v, want error type %v", err, tt.errorType)
+				}
+				return
+			}
+			if net.String() != tt.wantNet {
+				t.Errorf("LineBreakdown() net = %v, want %v", net, tt.wantNet)
+			}
+			if tax.String() != tt.wantTax {
+				t.Errorf("LineBreakdown() tax = %v, want %v", tax, tt.wantTax)
+			}
+			if gross.String() != tt.wantGross {
+				t.Errorf("LineBreakdown() gross = %v, want %v", gross, tt.wantGross)
+			}
+			if !net.Add(tax).Equal(gross) {
+				t.Errorf("LineBreakdown() net %v + tax %v != gross %v", net, tax, gross)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/nduyhai/finarith/errors"
+	"github.com/nduyhai/finarith/safedec"
+)
+
+// TaxTable maps region codes to the TaxRule that applies in each region.
+// The zero value is an empty table ready for use.
+type TaxTable struct {
+	rules map[string]*TaxRule
+}
+
+// NewTaxTable creates a new TaxTable from a map of region codes to tax rules.
+// The map is copied, so later changes to it do not affect the table.
+func NewTaxTable(rulesByRegion map[string]*TaxRule) *TaxTable {
+	rules := make(map[string]*TaxRule, len(rulesByRegion))
+	for region, rule := range rulesByRegion {
+		rules[region] = rule
+	}
+	return &TaxTable{
+		rules: rules,
+	}
+}
+
+// SetRule sets the tax rule for a region, replacing any existing rule.
+func (t *TaxTable) SetRule(region string, rule *TaxRule) {
+	if t.rules == nil {
+		t.rules = make(map[string]*TaxRule)
+	}
+	t.rules[region] = rule
+}
+
+// Rule returns the tax rule for a region and whether the region is known.
+func (t *TaxTable) Rule(region string) (*TaxRule, bool) {
+	rule, ok := t.rules[region]
+	return rule, ok
+}
+
+// Calculate calculates the tax on the amount using the rule for the given region.
+// Returns an error if the region is unknown or if the tax calculation fails.
+func (t *TaxTable) Calculate(region string, amount safedec.Decimal) (safedec.Decimal, error) {
+	rule, ok := t.rules[region]
+	if !ok {
+		return safedec.Zero(), fmt.Errorf("%w: unknown tax region %q", errors.ErrInvalidArgument, region)
+	}
+	return rule.CalculateTax(amount)
+}
@@ -0,0 +1,118 @@
+package rules
+
+import (
+	"errors"
+	"testing"
+
+	finerrors "github.com/nduyhai/finarith/errors"
+	"github.com/nduyhai/finarith/rounding"
+	"github.com/nduyhai/finarith/safedec"
+)
+
+func newTestTaxTable() *TaxTable {
+	minTaxableAmount := safedec.Zero()
+	maxTaxAmount, _ := safedec.NewFromString("100000.00")
+	caRate, _ := safedec.NewFromString("7.25")
+	nyRate, _ := safedec.NewFromString("4.00")
+
+	return NewTaxTable(map[string]*TaxRule{
+		"US-CA": NewTaxRule(caRate, minTaxableAmount, maxTaxAmount, rounding.RoundHalfUp, 2),
+		"US-NY": NewTaxRule(nyRate, minTaxableAmount, maxTaxAmount, rounding.RoundHalfUp, 2),
+	})
+}
+
+func TestTaxTable_Calculate(t *testing.T) {
+	tests := []struct {
+		name    string
+		region  string
+		amount  string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:   "California",
+			region: "US-CA",
+			amount: "100.00",
+			want:   "7.25",
+		},
+		{
+			name:   "New York",
+			region: "US-NY",
+			amount: "100.00",
+			want:   "4",
+		},
+		{
+			name:   "rounding applied",
+			region: "US-CA",
+			amount: "19.99",
+			want:   "1.45",
+		},
+		{
+			name:    "unknown region",
+			region:  "US-TX",
+			amount:  "100.00",
+			wantErr: true,
+		},
+	}
+
+	table := newTestTaxTable()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			amount, _ := safedec.NewFromString(tt.amount)
+
+			got, err := table.Calculate(tt.region, amount)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Calculate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil && !errors.Is(err, finerrors.ErrInvalidArgument) {
+				t.Errorf("Calculate() error type = %v, want %v", err, finerrors.ErrInvalidArgument)
+			}
+			if !tt.wantErr && got.String() != tt.want {
+				t.Errorf("Calculate() = %v, want %v", got.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestTaxTable_SetRule(t *testing.T) {
+	table := newTestTaxTable()
+
+	if _, ok := table.Rule("US-TX"); ok {
+		t.Fatalf("Rule(US-TX) found before it was set")
+	}
+
+	txRate, _ := safedec.NewFromString("6.25")
+	maxTaxAmount, _ := safedec.NewFromString("100000.00")
+	table.SetRule("US-TX", NewTaxRule(txRate, safedec.Zero(), maxTaxAmount, rounding.RoundHalfUp, 2))
+
+	amount, _ := safedec.NewFromString("100.00")
+	got, err := table.Calculate("US-TX", amount)
+	if err != nil {
+		t.Fatalf("Calculate() error = %v", err)
+	}
+	if got.String() != "6.25" {
+		t.Errorf("Calculate() = %v, want 6.25", got.String())
+	}
+}
+
+func TestTaxTable_ZeroValue(t *testing.T) {
+	var table TaxTable
+
+	amount, _ := safedec.NewFromString("100.00")
+	if _, err := table.Calculate("US-TX", amount); !errors.Is(err, finerrors.ErrInvalidArgument) {
+		t.Fatalf("Calculate() on empty table error = %v, want %v", err, finerrors.ErrInvalidArgument)
+	}
+
+	txRate, _ := safedec.NewFromString("6.25")
+	maxTaxAmount, _ := safedec.NewFromString("100000.00")
+	table.SetRule("US-TX", NewTaxRule(txRate, safedec.Zero(), maxTaxAmount, rounding.RoundHalfUp, 2))
+
+	got, err := table.Calculate("US-TX", amount)
+	if err != nil {
+		t.Fatalf("Calculate() error = %v", err)
+	}
+	if got.String() != "6.25" {
+		t.Errorf("Calculate() = %v, want 6.25", got.String())
+	}
+}
@@ -0,0 +1,129 @@
+package rules
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nduyhai/finarith/errors"
+	"github.com/nduyhai/finarith/money"
+	"github.com/nduyhai/finarith/safedec"
+)
+
+// BatchResult summarizes the validation of a batch of transfers.
+type BatchResult struct {
+	// Passed is the number of transfers that passed validation.
+	Passed int
+
+	// Failed is the number of transfers that failed validation.
+	Failed int
+
+	// TotalValid is the sum of the amounts of the transfers that passed validation.
+	TotalValid safedec.Decimal
+
+	// Errors holds the validation error for each transfer, indexed like the batch.
+	// The entry is nil for transfers that passed.
+	Errors []error
+}
+
+// TransferInput is a single transfer to validate together with the account state it is checked against.
+type TransferInput struct {
+	// Amount is the amount of the transfer.
+	Amount safedec.Decimal
+
+	// SourceBalance is the balance of the source account before the transfer.
+	SourceBalance safedec.Decimal
+
+	// DailyTotal is the total already transferred from the source account today.
+	DailyTotal safedec.Decimal
+}
+
+// ValidateBatch validates each transfer in the batch independently with ValidateTransfer and
+// returns the validation error for each transfer, indexed like the batch, which is nil for
+// transfers that passed. The context is checked before each transfer, so a long batch stops
+// early once the context is done.
+// Returns ErrEmptyInput if the batch is empty, or the context's error if it is done before every
+// transfer is validated, together with the errors of the transfers validated so far.
+func (r *TransferRule) ValidateBatch(ctx context.Context, transfers []TransferInput) ([]error, error) {
+	if len(transfers) == 0 {
+		return nil, errors.ErrEmptyInput
+	}
+
+	errs := make([]error, len(transfers))
+	for i, t := range transfers {
+		if err := ctx.Err(); err != nil {
+			return errs[:i], err
+		}
+		errs[i] = r.ValidateTransfer(t.Amount, t.SourceBalance, t.DailyTotal)
+	}
+
+	return errs, nil
+}
+
+// ValidateBatchSummary validates each transfer in the batch in order and summarizes the results.
+// Every transfer that passes is counted against the daily total and the balance seen by the
+// transfers after it, while failed transfers are skipped.
+// Returns an error if the batch is empty.
+func (r *TransferRule) ValidateBatchSummary(transfers []safedec.Decimal, dailyTotal, balance safedec.Decimal) (BatchResult, error) {
+	if len(transfers) == 0 {
+		return BatchResult{}, errors.ErrEmptyInput
+	}
+
+	result := BatchResult{
+		TotalValid: safedec.Zero(),
+		Errors:     make([]error, len(transfers)),
+	}
+
+	for i, amount := range transfers {
+		if err := r.ValidateTransfer(amount, balance, dailyTotal); err != nil {
+			result.Errors[i] = err
+			result.Failed++
+			continue
+		}
+
+		result.Passed++
+		result.TotalValid = result.TotalValid.Add(amount)
+		dailyTotal = dailyTotal.Add(amount)
+		balance = balance.Sub(amount)
+	}
+
+	return result, nil
+}
+
+// ValidateMoneyBatch validates a batch of transfers in mixed currencies, such as a multi-currency
+// settlement file, checking each item against the rule for its currency in rulesByCurrency, which
+// is keyed by upper-case ISO 4217 code. Items are checked against the minimum, maximum, and daily
+// limits of their rule, and every item that passes counts toward the daily total seen by later
+// items in the same currency. A batch carries no account balances, so balances are not checked.
+// The returned slice holds the validation error for each item, indexed like the batch, and is nil
+// for items that passed.
+// Returns ErrEmptyInput if the batch is empty, or an error wrapping ErrInvalidArgument if any
+// currency in the batch has no rule, in which case no items are validated.
+func ValidateMoneyBatch(items []money.Money, rulesByCurrency map[string]*TransferRule) ([]error, error) {
+	if len(items) == 0 {
+		return nil, errors.ErrEmptyInput
+	}
+
+	for _, item := range items {
+		if rulesByCurrency[item.Currency()] == nil {
+			return nil, fmt.Errorf("%w: no transfer rule for currency %s", errors.ErrInvalidArgument, item.Currency())
+		}
+	}
+
+	itemErrors := make([]error, len(items))
+	dailyTotals := make(map[string]safedec.Decimal)
+	for i, item := range items {
+		code := item.Currency()
+		dailyTotal, ok := dailyTotals[code]
+		if !ok {
+			dailyTotal = safedec.Zero()
+		}
+
+		if err := rulesByCurrency[code].validateLimits(item.Amount(), dailyTotal); err != nil {
+			itemErrors[i] = err
+			continue
+		}
+		dailyTotals[code] = dailyTotal.Add(item.Amount())
+	}
+
+	return itemErrors, nil
+}
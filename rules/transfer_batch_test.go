@@ -0,0 +1,321 @@
+package rules
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	finerrors "github.com/nduyhai/finarith/errors"
+	"github.com/nduyhai/finarith/money"
+	"github.com/nduyhai/finarith/safedec"
+)
+
+// countdownContext is a context that reports itself canceled once Err has been called n times.
+type countdownContext struct {
+	context.Context
+	n int
+}
+
+func (c *countdownContext) Err() error {
+	if c.n <= 0 {
+		return context.Canceled
+	}
+	c.n--
+	return nil
+}
+
+func TestTransferRule_ValidateBatch(t *testing.T) {
+	maxAmount, _ := safedec.NewFromString("1000.00")
+	minAmount, _ := safedec.NewFromString("10.00")
+	dailyLimit, _ := safedec.NewFromString("2000.00")
+	rule := NewTransferRule(maxAmount, minAmount, dailyLimit, false)
+
+	input := func(amount, balance, dailyTotal string) TransferInput {
+		a, _ := safedec.NewFromString(amount)
+		b, _ := safedec.NewFromString(balance)
+		d, _ := safedec.NewFromString(dailyTotal)
+		return TransferInput{Amount: a, SourceBalance: b, DailyTotal: d}
+	}
+	batch := []TransferInput{
+		input("100.00", "500.00", "0"),
+		input("5.00", "500.00", "0"),
+		input("600.00", "500.00", "0"),
+		input("300.00", "500.00", "1800.00"),
+		input("250.00", "500.00", "1000.00"),
+	}
+
+	tests := []struct {
+		name         string
+		ctx          context.Context
+		transfers    []TransferInput
+		wantLen      int
+		wantFailedAt []int
+		wantErr      error
+	}{
+		{
+			name:         "all validated",
+			ctx:          context.Background(),
+			transfers:    batch,
+			wantLen:      5,
+			wantFailedAt: []int{1, 2, 3},
+		},
+		{
+			name:      "canceled before start",
+			ctx:       &countdownContext{Context: context.Background(), n: 0},
+			transfers: batch,
+			wantLen:   0,
+			wantErr:   context.Canceled,
+		},
+		{
+			name:         "canceled mid-batch",
+			ctx:          &countdownContext{Context: context.Background(), n: 3},
+			transfers:    batch,
+			wantLen:      3,
+			wantFailedAt: []int{1, 2},
+			wantErr:      context.Canceled,
+		},
+		{
+			name:      "empty batch",
+			ctx:       context.Background(),
+			transfers: nil,
+			wantErr:   finerrors.ErrEmptyInput,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := rule.ValidateBatch(tt.ctx, tt.transfers)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ValidateBatch() error = %v, want %v", err, tt.wantErr)
+			}
+			if len(got) != tt.wantLen {
+				t.Fatalf("ValidateBatch() returned %d errors, want %d", len(got), tt.wantLen)
+			}
+
+			failed := make(map[int]bool)
+			for _, i := range tt.wantFailedAt {
+				failed[i] = true
+			}
+			for i, itemErr := range got {
+				if (itemErr != nil) != failed[i] {
+					t.Errorf("ValidateBatch() error[%d] = %v, want failure %v", i, itemErr, failed[i])
+				}
+			}
+		})
+	}
+}
+
+func TestTransferRule_ValidateBatchCanceledContext(t *testing.T) {
+	rule := NewTransferRule(safedec.NewFromInt(1000), safedec.NewFromInt(10), safedec.NewFromInt(2000), false)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	transfers := []TransferInput{{Amount: safedec.NewFromInt(100), SourceBalance: safedec.NewFromInt(500), DailyTotal: safedec.Zero()}}
+	if _, err := rule.ValidateBatch(ctx, transfers); !errors.Is(err, context.Canceled) {
+		t.Errorf("ValidateBatch() error = %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestTransferRule_ValidateBatchSummary(t *testing.T) {
+	maxAmount, _ := safedec.NewFromString("1000.00")
+	minAmount, _ := safedec.NewFromString("10.00")
+	dailyLimit, _ := safedec.NewFromString("2000.00")
+	rule := NewTransferRule(maxAmount, minAmount, dailyLimit, false)
+
+	tests := []struct {
+		name           string
+		transfers      []string
+		dailyTotal     string
+		balance        string
+		wantPassed     int
+		wantFailed     int
+		wantTotalValid string
+		wantFailedAt   []int
+		wantErr        bool
+	}{
+		{
+			name:           "mix of valid and invalid transfers",
+			transfers:      []string{"500.00", "5.00", "1500.00", "400.00", "300.00"},
+			dailyTotal:     "0",
+			balance:        "1000.00",
+			wantPassed:     2,
+			wantFailed:     3,
+			wantTotalValid: "900",
+			wantFailedAt:   []int{1, 2, 4},
+		},
+		{
+			name:           "daily limit reached mid-batch",
+			transfers:      []string{"800.00", "800.00", "800.00"},
+			dailyTotal:     "0",
+			balance:        "5000.00",
+			wantPassed:     2,
+			wantFailed:     1,
+			wantTotalValid: "1600",
+			wantFailedAt:   []int{2},
+		},
+		{
+			name:           "all valid",
+			transfers:      []string{"100.00", "200.00"},
+			dailyTotal:     "0",
+			balance:        "5000.00",
+			wantPassed:     2,
+			wantFailed:     0,
+			wantTotalValid: "300",
+			wantFailedAt:   []int{},
+		},
+		{
+			name:       "empty batch",
+			transfers:  []string{},
+			dailyTotal: "0",
+			balance:    "5000.00",
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transfers := make([]safedec.Decimal, len(tt.transfers))
+			for i, transfer := range tt.transfers {
+				transfers[i], _ = safedec.NewFromString(transfer)
+			}
+			dailyTotal, _ := safedec.NewFromString(tt.dailyTotal)
+			balance, _ := safedec.NewFromString(tt.balance)
+
+			got, err := rule.ValidateBatchSummary(transfers, dailyTotal, balance)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateBatchSummary() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				if !errors.Is(err, finerrors.ErrEmptyInput) {
+					t.Errorf("ValidateBatchSummary() error type = %v, want %v", err, finerrors.ErrEmptyInput)
+				}
+				return
+			}
+
+			if got.Passed != tt.wantPassed {
+				t.Errorf("ValidateBatchSummary() Passed = %v, want %v", got.Passed, tt.wantPassed)
+			}
+			if got.Failed != tt.wantFailed {
+				t.Errorf("ValidateBatchSummary() Failed = %v, want %v", got.Failed, tt.wantFailed)
+			}
+			if got.TotalValid.String() != tt.wantTotalValid {
+				t.Errorf("ValidateBatchSummary() TotalValid = %v, want %v", got.TotalValid, tt.wantTotalValid)
+			}
+
+			failed := make(map[int]bool, len(tt.wantFailedAt))
+			for _, i := range tt.wantFailedAt {
+				failed[i] = true
+			}
+			for i, err := range got.Errors {
+				if (err != nil) != failed[i] {
+					t.Errorf("ValidateBatchSummary() Errors[%d] = %v, want failure %v", i, err, failed[i])
+				}
+				if err != nil && !errors.Is(err, finerrors.ErrExceedsLimit) {
+					t.Errorf("ValidateBatchSummary() Errors[%d] type = %v, want %v", i, err, finerrors.ErrExceedsLimit)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateMoneyBatch(t *testing.T) {
+	usdRule := NewTransferRule(safedec.NewFromInt(1000), safedec.NewFromInt(10), safedec.NewFromInt(1500), false)
+	eurRule := NewTransferRule(safedec.NewFromInt(500), safedec.NewFromInt(5), safedec.NewFromInt(600), false)
+	rulesByCurrency := map[string]*TransferRule{
+		"USD": usdRule,
+		"EUR": eurRule,
+	}
+
+	tests := []struct {
+		name      string
+		items     [][2]string
+		rules     map[string]*TransferRule
+		wantFail  []bool
+		wantErr   bool
+		errorType error
+	}{
+		{
+			name:     "all valid",
+			items:    [][2]string{{"100.00", "USD"}, {"50.00", "EUR"}, {"900.00", "USD"}},
+			rules:    rulesByCurrency,
+			wantFail: []bool{false, false, false},
+		},
+		{
+			name:     "limits applied per currency",
+			items:    [][2]string{{"800.00", "USD"}, {"800.00", "EUR"}, {"8.00", "EUR"}, {"8.00", "USD"}},
+			rules:    rulesByCurrency,
+			wantFail: []bool{false, true, false, true},
+		},
+		{
+			name:     "daily totals tracked per currency",
+			items:    [][2]string{{"500.00", "EUR"}, {"1000.00", "USD"}, {"500.00", "USD"}, {"100.01", "EUR"}, {"100.00", "EUR"}},
+			rules:    rulesByCurrency,
+			wantFail: []bool{false, false, false, true, false},
+		},
+		{
+			name:     "failed items do not count toward the daily total",
+			items:    [][2]string{{"1200.00", "USD"}, {"1000.00", "USD"}, {"500.00", "USD"}},
+			rules:    rulesByCurrency,
+			wantFail: []bool{true, false, false},
+		},
+		{
+			name:      "empty batch",
+			items:     nil,
+			rules:     rulesByCurrency,
+			wantErr:   true,
+			errorType: finerrors.ErrEmptyInput,
+		},
+		{
+			name:      "currency without a rule",
+			items:     [][2]string{{"100.00", "USD"}, {"100.00", "GBP"}},
+			rules:     rulesByCurrency,
+			wantErr:   true,
+			errorType: finerrors.ErrInvalidArgument,
+		},
+		{
+			name:      "nil rule",
+			items:     [][2]string{{"100.00", "USD"}},
+			rules:     map[string]*TransferRule{"USD": nil},
+			wantErr:   true,
+			errorType: finerrors.ErrInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			items := make([]money.Money, len(tt.items))
+			for i, item := range tt.items {
+				var err error
+				items[i], err = money.NewFromString(item[0], item[1])
+				if err != nil {
+					t.Fatalf("money.NewFromString() error = %v", err)
+				}
+			}
+
+			got, err := ValidateMoneyBatch(items, tt.rules)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateMoneyBatch() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				if !errors.Is(err, tt.errorType) {
+					t.Errorf("ValidateMoneyBatch() error type = %v, want %v", err, tt.errorType)
+				}
+				return
+			}
+
+			if len(got) != len(tt.wantFail) {
+				t.Fatalf("ValidateMoneyBatch() returned %d errors, want %d", len(got), len(tt.wantFail))
+			}
+			for i, wantFail := range tt.wantFail {
+				if (got[i] != nil) != wantFail {
+					t.Errorf("ValidateMoneyBatch() error[%d] = %v, want failure %v", i, got[i], wantFail)
+				}
+				if got[i] != nil && !errors.Is(got[i], finerrors.ErrExceedsLimit) {
+					t.Errorf("ValidateMoneyBatch() error[%d] = %v, want %v", i, got[i], finerrors.ErrExceedsLimit)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package rules
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/nduyhai/finarith/safedec"
+)
+
+// transferRuleSchemaVersion is the current version of the TransferRule JSON representation.
+const transferRuleSchemaVersion = 1
+
+// transferRuleJSON is the canonical JSON representation of a TransferRule.
+// Amounts are encoded as strings so no precision is lost.
+type transferRuleJSON struct {
+	SchemaVersion        int    `json:"schema_version"`
+	MaxAmount            string `json:"max_amount"`
+	MinAmount            string `json:"min_amount"`
+	DailyLimit           string `json:"daily_limit"`
+	AllowNegativeBalance bool   `json:"allow_negative_balance"`
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (r TransferRule) MarshalJSON() ([]byte, error) {
+	return json.Marshal(transferRuleJSON{
+		SchemaVersion:        transferRuleSchemaVersion,
+		MaxAmount:            r.MaxAmount.String(),
+		MinAmount:            r.MinAmount.String(),
+		DailyLimit:           r.DailyLimit.String(),
+		AllowNegativeBalance: r.AllowNegativeBalance,
+	})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// Returns an error if the schema version is not supported or if any amount is not a valid decimal.
+func (r *TransferRule) UnmarshalJSON(data []byte) error {
+	var raw transferRuleJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	if raw.SchemaVersion != transferRuleSchemaVersion {
+		return fmt.Errorf("unsupported transfer rule schema version %d", raw.SchemaVersion)
+	}
+
+	maxAmount, err := safedec.NewFromString(raw.MaxAmount)
+	if err != nil {
+		return fmt.Errorf("max_amount: %w", err)
+	}
+	minAmount, err := safedec.NewFromString(raw.MinAmount)
+	if err != nil {
+		return fmt.Errorf("min_amount: %w", err)
+	}
+	dailyLimit, err := safedec.NewFromString(raw.DailyLimit)
+	if err != nil {
+		return fmt.Errorf("daily_limit: %w", err)
+	}
+
+	r.MaxAmount = maxAmount
+	r.MinAmount = minAmount
+	r.DailyLimit = dailyLimit
+	r.AllowNegativeBalance = raw.AllowNegativeBalance
+	return nil
+}
+
+// ToJSON returns the canonical JSON representation of the rule.
+func (r *TransferRule) ToJSON() ([]byte, error) {
+	return json.Marshal(r)
+}
+
+// ParseTransferRuleJSON creates a new TransferRule from its canonical JSON representation.
+func ParseTransferRuleJSON(data []byte) (*TransferRule, error) {
+	r := &TransferRule{}
+	if err := json.Unmarshal(data, r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
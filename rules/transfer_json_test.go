@@ -0,0 +1,134 @@
+package rules
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/nduyhai/finarith/safedec"
+)
+
+func TestTransferRule_ToJSON(t *testing.T) {
+	maxAmount, _ := safedec.NewFromString("1000.00")
+	minAmount, _ := safedec.NewFromString("10.00")
+	dailyLimit, _ := safedec.NewFromString("5000.50")
+	rule := NewTransferRule(maxAmount, minAmount, dailyLimit, true)
+
+	data, err := rule.ToJSON()
+	if err != nil {
+		t.Fatalf("ToJSON() error = %v", err)
+	}
+
+	want := `{"schema_version":1,"max_amount":"1000","min_amount":"10","daily_limit":"5000.5","allow_negative_balance":true}`
+	if string(data) != want {
+		t.Errorf("ToJSON() = %s, want %s", data, want)
+	}
+}
+
+func TestTransferRule_MarshalJSONValue(t *testing.T) {
+	maxAmount, _ := safedec.NewFromString("1000.00")
+	minAmount, _ := safedec.NewFromString("10.00")
+	dailyLimit, _ := safedec.NewFromString("5000.50")
+	rules := map[string]TransferRule{
+		"retail": *NewTransferRule(maxAmount, minAmount, dailyLimit, false),
+	}
+
+	data, err := json.Marshal(rules)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+
+	want := `{"retail":{"schema_version":1,"max_amount":"1000","min_amount":"10","daily_limit":"5000.5","allow_negative_balance":false}}`
+	if string(data) != want {
+		t.Errorf("json.Marshal() = %s, want %s", data, want)
+	}
+}
+
+func TestParseTransferRuleJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr bool
+	}{
+		{
+			name: "valid rule",
+			data: `{"schema_version":1,"max_amount":"1000","min_amount":"10","daily_limit":"5000","allow_negative_balance":false}`,
+		},
+		{
+			name:    "unsupported schema version",
+			data:    `{"schema_version":2,"max_amount":"1000","min_amount":"10","daily_limit":"5000","allow_negative_balance":false}`,
+			wantErr: true,
+		},
+		{
+			name:    "missing schema version",
+			data:    `{"max_amount":"1000","min_amount":"10","daily_limit":"5000","allow_negative_balance":false}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid amount",
+			data:    `{"schema_version":1,"max_amount":"abc","min_amount":"10","daily_limit":"5000","allow_negative_balance":false}`,
+			wantErr: true,
+		},
+		{
+			name:    "missing amount",
+			data:    `{"schema_version":1,"max_amount":"1000","min_amount":"10","allow_negative_balance":false}`,
+			wantErr: true,
+		},
+		{
+			name:    "malformed JSON",
+			data:    `{"schema_version":1,`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseTransferRuleJSON([]byte(tt.data))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseTransferRuleJSON() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestTransferRule_JSONRoundTrip(t *testing.T) {
+	maxAmount, _ := safedec.NewFromString("1000.00")
+	minAmount, _ := safedec.NewFromString("10.00")
+	dailyLimit, _ := safedec.NewFromString("5000.00")
+
+	tests := []struct {
+		amount        string
+		sourceBalance string
+		dailyTotal    string
+	}{
+		{amount: "500.00", sourceBalance: "600.00", dailyTotal: "4000.00"},
+		{amount: "5.00", sourceBalance: "600.00", dailyTotal: "4000.00"},
+		{amount: "1500.00", sourceBalance: "2000.00", dailyTotal: "4000.00"},
+		{amount: "1000.00", sourceBalance: "2000.00", dailyTotal: "4500.00"},
+		{amount: "700.00", sourceBalance: "600.00", dailyTotal: "4000.00"},
+	}
+
+	for _, allowNegativeBalance := range []bool{false, true} {
+		original := NewTransferRule(maxAmount, minAmount, dailyLimit, allowNegativeBalance)
+
+		data, err := json.Marshal(original)
+		if err != nil {
+			t.Fatalf("json.Marshal() error = %v", err)
+		}
+		restored, err := ParseTransferRuleJSON(data)
+		if err != nil {
+			t.Fatalf("ParseTransferRuleJSON() error = %v", err)
+		}
+
+		for _, tt := range tests {
+			amount, _ := safedec.NewFromString(tt.amount)
+			sourceBalance, _ := safedec.NewFromString(tt.sourceBalance)
+			dailyTotal, _ := safedec.NewFromString(tt.dailyTotal)
+
+			wantErr := original.ValidateTransfer(amount, sourceBalance, dailyTotal)
+			gotErr := restored.ValidateTransfer(amount, sourceBalance, dailyTotal)
+			if (gotErr == nil) != (wantErr == nil) || (wantErr != nil && gotErr.Error() != wantErr.Error()) {
+				t.Errorf("restored ValidateTransfer(%v) error = %v, want %v", tt.amount, gotErr, wantErr)
+			}
+		}
+	}
+}
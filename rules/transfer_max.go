@@ -0,0 +1,41 @@
+package rules
+
+import (
+	"github.com/nduyhai/finarith/errors"
+	"github.com/nduyhai/finarith/rounding"
+	"github.com/nduyhai/finarith/safedec"
+)
+
+// MaxTransferableMultiple returns the largest multiple of increment that can be transferred
+// without exceeding MaxAmount, the remaining daily limit, or, unless AllowNegativeBalance is set,
+// the source balance.
+// Returns ErrInvalidArgument if increment is not positive, or a LimitError if no positive
+// multiple of increment satisfies the rule, including MinAmount.
+func (r *TransferRule) MaxTransferableMultiple(balance, dailyTotal, increment safedec.Decimal) (safedec.Decimal, error) {
+	if !increment.IsPositive() {
+		return safedec.Zero(), errors.ErrInvalidArgument
+	}
+
+	// Find the raw headroom permitted by every constraint
+	headroom := safedec.MinValue(r.MaxAmount, r.DailyLimit.Sub(dailyTotal))
+	if !r.AllowNegativeBalance {
+		headroom = safedec.MinValue(headroom, balance)
+	}
+
+	if !headroom.IsPositive() {
+		return safedec.Zero(), errors.NewLimitError(headroom.String(), r.MinAmount.String(), "minimum transfer")
+	}
+
+	// Reduce the headroom to a whole number of increments, truncating exactly so that a quotient
+	// just below a whole number is not rounded up past the headroom
+	amount, err := safedec.RoundToIncrement(headroom, increment, rounding.RoundDown)
+	if err != nil {
+		return safedec.Zero(), err
+	}
+
+	if !amount.IsPositive() || amount.LessThan(r.MinAmount) {
+		return safedec.Zero(), errors.NewLimitError(amount.String(), r.MinAmount.String(), "minimum transfer")
+	}
+
+	return amount, nil
+}
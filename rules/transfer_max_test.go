@@ -0,0 +1,128 @@
+package rules
+
+import (
+	"errors"
+	"testing"
+
+	finerrors "github.com/nduyhai/finarith/errors"
+	"github.com/nduyhai/finarith/safedec"
+)
+
+func TestTransferRule_MaxTransferableMultiple(t *testing.T) {
+	maxAmount, _ := safedec.NewFromString("1000.00")
+	minAmount, _ := safedec.NewFromString("20.00")
+	dailyLimit, _ := safedec.NewFromString("5000.00")
+
+	tests := []struct {
+		name          string
+		allowNegative bool
+		balance       string
+		dailyTotal    string
+		increment     string
+		want          string
+		wantErr       bool
+		errorType     error
+	}{
+		{
+			name:       "limited by max amount",
+			balance:    "10000.00",
+			dailyTotal: "0",
+			increment:  "20",
+			want:       "1000",
+		},
+		{
+			name:       "increment reduces balance headroom",
+			balance:    "735.50",
+			dailyTotal: "0",
+			increment:  "20",
+			want:       "720",
+		},
+		{
+			name:       "increment reduces daily headroom",
+			balance:    "10000.00",
+			dailyTotal: "4410.00",
+			increment:  "50",
+			want:       "550",
+		},
+		{
+			name:       "fractional increment",
+			balance:    "100.37",
+			dailyTotal: "0",
+			increment:  "0.25",
+			want:       "100.25",
+		},
+		{
+			name:       "balance just below a whole increment",
+			balance:    "20.99999999999999999",
+			dailyTotal: "0",
+			increment:  "1",
+			want:       "20",
+		},
+		{
+			name:          "negative balance allowed",
+			allowNegative: true,
+			balance:       "0",
+			dailyTotal:    "0",
+			increment:     "20",
+			want:          "1000",
+		},
+		{
+			name:       "below minimum after rounding to increment",
+			balance:    "39.99",
+			dailyTotal: "0",
+			increment:  "50",
+			wantErr:    true,
+			errorType:  finerrors.ErrExceedsLimit,
+		},
+		{
+			name:       "daily limit exhausted",
+			balance:    "10000.00",
+			dailyTotal: "5000.00",
+			increment:  "20",
+			wantErr:    true,
+			errorType:  finerrors.ErrExceedsLimit,
+		},
+		{
+			name:       "zero increment",
+			balance:    "100.00",
+			dailyTotal: "0",
+			increment:  "0",
+			wantErr:    true,
+			errorType:  finerrors.ErrInvalidArgument,
+		},
+		{
+			name:       "negative increment",
+			balance:    "100.00",
+			dailyTotal: "0",
+			increment:  "-20",
+			wantErr:    true,
+			errorType:  finerrors.ErrInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule := NewTransferRule(maxAmount, minAmount, dailyLimit, tt.allowNegative)
+			balance, _ := safedec.NewFromString(tt.balance)
+			dailyTotal, _ := safedec.NewFromString(tt.dailyTotal)
+			increment, _ := safedec.NewFromString(tt.increment)
+
+			got, err := rule.MaxTransferableMultiple(balance, dailyTotal, increment)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MaxTransferableMultiple() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil && tt.errorType != nil && !errors.Is(err, tt.errorType) {
+				t.Errorf("MaxTransferableMultiple() error = %v, want error type %v", err, tt.errorType)
+			}
+			if !tt.wantErr {
+				if got.String() != tt.want {
+					t.Errorf("MaxTransferableMultiple() = %v, want %v", got.String(), tt.want)
+				}
+				if err := rule.ValidateTransfer(got, balance, dailyTotal); err != nil {
+					t.Errorf("ValidateTransfer() rejected MaxTransferableMultiple() result: %v", err)
+				}
+			}
+		})
+	}
+}
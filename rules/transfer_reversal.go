@@ -0,0 +1,28 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/nduyhai/finarith/errors"
+	"github.com/nduyhai/finarith/safedec"
+)
+
+// ValidateReversal validates a reversal or chargeback of a transfer of originalAmount.
+// A reversal may be partial or full, but must be positive and no larger than the original.
+// Returns ErrNegativeValue if the original amount is negative, ErrInvalidArgument if the
+// reversal is not positive, or an error wrapping ErrReversalExceedsOriginal if the reversal is
+// larger than the original.
+func (r *TransferRule) ValidateReversal(originalAmount, reversalAmount safedec.Decimal) error {
+	if originalAmount.IsNegative() {
+		return errors.ErrNegativeValue
+	}
+	if !reversalAmount.IsPositive() {
+		return errors.ErrInvalidArgument
+	}
+
+	if reversalAmount.GreaterThan(originalAmount) {
+		return fmt.Errorf("%w: reversal %s, original %s", errors.ErrReversalExceedsOriginal, reversalAmount, originalAmount)
+	}
+
+	return nil
+}
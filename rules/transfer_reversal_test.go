@@ -0,0 +1,84 @@
+package rules
+
+import (
+	"errors"
+	"testing"
+
+	finerrors "github.com/nduyhai/finarith/errors"
+	"github.com/nduyhai/finarith/safedec"
+)
+
+func TestTransferRule_ValidateReversal(t *testing.T) {
+	maxAmount, _ := safedec.NewFromString("1000.00")
+	minAmount, _ := safedec.NewFromString("20.00")
+	dailyLimit, _ := safedec.NewFromString("5000.00")
+	rule := NewTransferRule(maxAmount, minAmount, dailyLimit, false)
+
+	tests := []struct {
+		name      string
+		original  string
+		reversal  string
+		wantErr   bool
+		errorType error
+	}{
+		{
+			name:     "partial reversal",
+			original: "250.00",
+			reversal: "99.99",
+		},
+		{
+			name:     "full reversal",
+			original: "250.00",
+			reversal: "250",
+		},
+		{
+			name:     "reversal below minimum transfer",
+			original: "250.00",
+			reversal: "0.01",
+		},
+		{
+			name:      "over-reversal",
+			original:  "250.00",
+			reversal:  "250.01",
+			wantErr:   true,
+			errorType: finerrors.ErrReversalExceedsOriginal,
+		},
+		{
+			name:      "zero reversal",
+			original:  "250.00",
+			reversal:  "0",
+			wantErr:   true,
+			errorType: finerrors.ErrInvalidArgument,
+		},
+		{
+			name:      "negative reversal",
+			original:  "250.00",
+			reversal:  "-10",
+			wantErr:   true,
+			errorType: finerrors.ErrInvalidArgument,
+		},
+		{
+			name:      "negative original",
+			original:  "-250.00",
+			reversal:  "10",
+			wantErr:   true,
+			errorType: finerrors.ErrNegativeValue,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original, _ := safedec.NewFromString(tt.original)
+			reversal, _ := safedec.NewFromString(tt.reversal)
+
+			err := rule.ValidateReversal(original, reversal)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateReversal() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil && !errors.Is(err, tt.errorType) {
+				t.Errorf("ValidateReversal() error type = %v, want %v", err, tt.errorType)
+			}
+		})
+	}
+}
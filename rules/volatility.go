@@ -0,0 +1,61 @@
+package rules
+
+import (
+	"github.com/nduyhai/finarith/errors"
+	"github.com/nduyhai/finarith/safedec"
+)
+
+// VolatilityRule represents a rule for rejecting prices that move too far from the last accepted price.
+type VolatilityRule struct {
+	// MaxAbsoluteChange is the maximum absolute difference allowed from the reference price.
+	MaxAbsoluteChange safedec.Decimal
+
+	// MaxRelativeChangePercent is the maximum change allowed, as a percentage of the reference price.
+	MaxRelativeChangePercent safedec.Decimal
+
+	// ReferencePrice is the last accepted price that new prices are compared against.
+	ReferencePrice safedec.Decimal
+}
+
+// NewVolatilityRule creates a new VolatilityRule with the specified limits and initial reference price.
+func NewVolatilityRule(maxAbsoluteChange, maxRelativeChangePercent, referencePrice safedec.Decimal) *VolatilityRule {
+	return &VolatilityRule{
+		MaxAbsoluteChange:        maxAbsoluteChange,
+		MaxRelativeChangePercent: maxRelativeChangePercent,
+		ReferencePrice:           referencePrice,
+	}
+}
+
+// ValidateNewPrice validates a new price against the reference price.
+// Returns an error if the absolute or the relative change exceeds its limit.
+// The relative check is skipped while the reference price is zero, as no percentage can be computed.
+func (r *VolatilityRule) ValidateNewPrice(newPrice safedec.Decimal) error {
+	change := newPrice.Sub(r.ReferencePrice).Abs()
+
+	// Check the absolute change
+	if change.GreaterThan(r.MaxAbsoluteChange) {
+		return errors.NewDeviationError(change.String(), r.MaxAbsoluteChange.String(), "absolute")
+	}
+
+	if r.ReferencePrice.IsZero() {
+		return nil
+	}
+
+	// Check the relative change as a percentage of the reference price
+	hundred := safedec.NewFromInt(100)
+	changePercent, err := change.Mul(hundred).Div(r.ReferencePrice.Abs())
+	if err != nil {
+		return err
+	}
+
+	if changePercent.GreaterThan(r.MaxRelativeChangePercent) {
+		return errors.NewDeviationError(changePercent.String()+"%", r.MaxRelativeChangePercent.String()+"%", "relative")
+	}
+
+	return nil
+}
+
+// UpdateReference sets the reference price, typically after a new price has been accepted.
+func (r *VolatilityRule) UpdateReference(price safedec.Decimal) {
+	r.ReferencePrice = price
+}
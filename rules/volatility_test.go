@@ -0,0 +1,134 @@
+package rules
+
+import (
+	"errors"
+	"testing"
+
+	finerrors "github.com/nduyhai/finarith/errors"
+	"github.com/nduyhai/finarith/safedec"
+)
+
+func TestNewVolatilityRule(t *testing.T) {
+	maxAbsoluteChange, _ := safedec.NewFromString("5.00")
+	maxRelativeChangePercent, _ := safedec.NewFromString("10.00")
+	referencePrice, _ := safedec.NewFromString("100.00")
+
+	rule := NewVolatilityRule(maxAbsoluteChange, maxRelativeChangePercent, referencePrice)
+
+	if !rule.MaxAbsoluteChange.Equal(maxAbsoluteChange) {
+		t.Errorf("NewVolatilityRule() MaxAbsoluteChange = %v, want %v", rule.MaxAbsoluteChange, maxAbsoluteChange)
+	}
+	if !rule.MaxRelativeChangePercent.Equal(maxRelativeChangePercent) {
+		t.Errorf("NewVolatilityRule() MaxRelativeChangePercent = %v, want %v", rule.MaxRelativeChangePercent, maxRelativeChangePercent)
+	}
+	if !rule.ReferencePrice.Equal(referencePrice) {
+		t.Errorf("NewVolatilityRule() ReferencePrice = %v, want %v", rule.ReferencePrice, referencePrice)
+	}
+}
+
+func TestVolatilityRule_ValidateNewPrice(t *testing.T) {
+	tests := []struct {
+		name           string
+		maxAbsolute    string
+		maxRelative    string
+		referencePrice string
+		newPrice       string
+		wantErr        bool
+	}{
+		{
+			name:           "within both limits",
+			maxAbsolute:    "5.00",
+			maxRelative:    "10.00",
+			referencePrice: "100.00",
+			newPrice:       "103.00",
+			wantErr:        false,
+		},
+		{
+			name:           "absolute change at boundary",
+			maxAbsolute:    "5.00",
+			maxRelative:    "10.00",
+			referencePrice: "100.00",
+			newPrice:       "95.00",
+			wantErr:        false,
+		},
+		{
+			name:           "absolute change exceeded",
+			maxAbsolute:    "5.00",
+			maxRelative:    "10.00",
+			referencePrice: "100.00",
+			newPrice:       "105.01",
+			wantErr:        true,
+		},
+		{
+			name:           "relative change at boundary",
+			maxAbsolute:    "1000.00",
+			maxRelative:    "10.00",
+			referencePrice: "200.00",
+			newPrice:       "220.00",
+			wantErr:        false,
+		},
+		{
+			name:           "relative change exceeded",
+			maxAbsolute:    "1000.00",
+			maxRelative:    "10.00",
+			referencePrice: "200.00",
+			newPrice:       "179.99",
+			wantErr:        true,
+		},
+		{
+			name:           "zero reference skips relative check",
+			maxAbsolute:    "5.00",
+			maxRelative:    "10.00",
+			referencePrice: "0",
+			newPrice:       "4.00",
+			wantErr:        false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			maxAbsolute, _ := safedec.NewFromString(tt.maxAbsolute)
+			maxRelative, _ := safedec.NewFromString(tt.maxRelative)
+			referencePrice, _ := safedec.NewFromString(tt.referencePrice)
+			rule := NewVolatilityRule(maxAbsolute, maxRelative, referencePrice)
+
+			newPrice, _ := safedec.NewFromString(tt.newPrice)
+
+			err := rule.ValidateNewPrice(newPrice)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateNewPrice() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if err != nil && !errors.Is(err, finerrors.ErrRateDeviation) {
+				t.Errorf("ValidateNewPrice() error type = %v, want %v", err, finerrors.ErrRateDeviation)
+			}
+		})
+	}
+}
+
+func TestVolatilityRule_UpdateReference(t *testing.T) {
+	maxAbsoluteChange, _ := safedec.NewFromString("5.00")
+	maxRelativeChangePercent, _ := safedec.NewFromString("10.00")
+	referencePrice, _ := safedec.NewFromString("100.00")
+	rule := NewVolatilityRule(maxAbsoluteChange, maxRelativeChangePercent, referencePrice)
+
+	// Walk the price up in accepted steps that would be rejected against the original reference
+	for _, price := range []string{"104.00", "108.00", "112.00"} {
+		newPrice, _ := safedec.NewFromString(price)
+		if err := rule.ValidateNewPrice(newPrice); err != nil {
+			t.Fatalf("ValidateNewPrice(%v) error = %v, want nil", price, err)
+		}
+		rule.UpdateReference(newPrice)
+	}
+
+	if rule.ReferencePrice.String() != "112" {
+		t.Errorf("UpdateReference() ReferencePrice = %v, want 112", rule.ReferencePrice)
+	}
+
+	// The original reference is no longer used
+	back, _ := safedec.NewFromString("100.00")
+	if err := rule.ValidateNewPrice(back); !errors.Is(err, finerrors.ErrRateDeviation) {
+		t.Errorf("ValidateNewPrice(100) error = %v, want %v", err, finerrors.ErrRateDeviation)
+	}
+}
@@ -0,0 +1,65 @@
+package safedec
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/big"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/nduyhai/finarith/errors"
+)
+
+// Sign bytes of the binary encoding.
+const (
+	binaryNonNegative byte = 0
+	binaryNegative    byte = 1
+)
+
+// binaryHeaderLen is the length of the sign byte and the exponent of the binary encoding.
+const binaryHeaderLen = 5
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// The decimal value is encoded as a sign byte (0 for non-negative, 1 for negative), the exponent
+// as a big-endian int32, and the magnitude of the coefficient as big-endian bytes, so the value
+// round-trips exactly, including its scale, without passing through a string.
+func (d Decimal) MarshalBinary() ([]byte, error) {
+	coefficient := d.value.Coefficient()
+
+	sign := binaryNonNegative
+	if coefficient.Sign() < 0 {
+		sign = binaryNegative
+	}
+	magnitude := coefficient.Abs(coefficient).Bytes()
+
+	data := make([]byte, binaryHeaderLen, binaryHeaderLen+len(magnitude))
+	data[0] = sign
+	binary.BigEndian.PutUint32(data[1:binaryHeaderLen], uint32(d.value.Exponent()))
+	return append(data, magnitude...), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, decoding a value encoded by MarshalBinary.
+// Returns an error wrapping ErrInvalidFormat if the data is too short, has an unknown sign byte,
+// or encodes a negative zero.
+func (d *Decimal) UnmarshalBinary(data []byte) error {
+	if len(data) < binaryHeaderLen {
+		return fmt.Errorf("%w: binary decimal needs at least %d bytes, got %d", errors.ErrInvalidFormat, binaryHeaderLen, len(data))
+	}
+
+	sign := data[0]
+	if sign != binaryNonNegative && sign != binaryNegative {
+		return fmt.Errorf("%w: unknown sign byte %d in binary decimal", errors.ErrInvalidFormat, sign)
+	}
+	exponent := int32(binary.BigEndian.Uint32(data[1:binaryHeaderLen]))
+
+	coefficient := new(big.Int).SetBytes(data[binaryHeaderLen:])
+	if sign == binaryNegative {
+		if coefficient.Sign() == 0 {
+			return fmt.Errorf("%w: negative zero in binary decimal", errors.ErrInvalidFormat)
+		}
+		coefficient.Neg(coefficient)
+	}
+
+	*d = Decimal{value: decimal.NewFromBigInt(coefficient, exponent)}
+	return nil
+}
@@ -0,0 +1,153 @@
+package safedec
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"testing"
+
+	finerrors "github.com/nduyhai/finarith/errors"
+)
+
+func TestDecimal_MarshalBinary(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  []byte
+	}{
+		{
+			name:  "zero",
+			value: "0",
+			want:  []byte{0, 0, 0, 0, 0},
+		},
+		{
+			name:  "cents",
+			value: "123.45",
+			want:  []byte{0, 0xff, 0xff, 0xff, 0xfe, 0x30, 0x39},
+		},
+		{
+			name:  "negative",
+			value: "-1",
+			want:  []byte{1, 0, 0, 0, 0, 0x01},
+		},
+		{
+			name:  "positive exponent",
+			value: "2e3",
+			want:  []byte{0, 0, 0, 0, 3, 0x02},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, _ := NewFromString(tt.value)
+
+			got, err := d.MarshalBinary()
+			if err != nil {
+				t.Errorf("MarshalBinary() error = %v", err)
+				return
+			}
+			if !bytes.Equal(got, tt.want) {
+				t.Errorf("MarshalBinary() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimal_BinaryRoundTrip(t *testing.T) {
+	values := []string{
+		"0",
+		"0.00",
+		"1",
+		"-1",
+		"123.45",
+		"-0.0001",
+		"100.10",
+		"1e3",
+		"-2.5e-20",
+		"9223372036854775807",
+		"-123456789012345678901234567890.123456789",
+	}
+
+	for _, v := range values {
+		t.Run(v, func(t *testing.T) {
+			d, _ := NewFromString(v)
+
+			data, err := d.MarshalBinary()
+			if err != nil {
+				t.Fatalf("MarshalBinary() error = %v", err)
+			}
+
+			got := One()
+			if err := got.UnmarshalBinary(data); err != nil {
+				t.Fatalf("UnmarshalBinary() error = %v", err)
+			}
+			if !got.Equal(d) {
+				t.Errorf("UnmarshalBinary() = %v, want %v", got, d)
+			}
+			if got.Value().Exponent() != d.Value().Exponent() {
+				t.Errorf("UnmarshalBinary() exponent = %v, want %v", got.Value().Exponent(), d.Value().Exponent())
+			}
+		})
+	}
+}
+
+func TestDecimal_UnmarshalBinaryInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{
+			name: "empty",
+			data: nil,
+		},
+		{
+			name: "truncated header",
+			data: []byte{0, 0, 0, 0},
+		},
+		{
+			name: "unknown sign",
+			data: []byte{2, 0, 0, 0, 0, 1},
+		},
+		{
+			name: "negative zero",
+			data: []byte{1, 0, 0, 0, 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := One()
+			err := d.UnmarshalBinary(tt.data)
+			if !errors.Is(err, finerrors.ErrInvalidFormat) {
+				t.Errorf("UnmarshalBinary() error = %v, want %v", err, finerrors.ErrInvalidFormat)
+			}
+			if !d.Equal(One()) {
+				t.Errorf("UnmarshalBinary() modified value on error: %v", d)
+			}
+		})
+	}
+}
+
+func TestDecimal_Gob(t *testing.T) {
+	type entry struct {
+		Amount Decimal
+		Fee    Decimal
+	}
+
+	amount, _ := NewFromString("1234.50")
+	fee, _ := NewFromString("-0.035")
+	want := entry{Amount: amount, Fee: fee}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(want); err != nil {
+		t.Fatalf("gob Encode() error = %v", err)
+	}
+
+	var got entry
+	if err := gob.NewDecoder(&buf).Decode(&got); err != nil {
+		t.Fatalf("gob Decode() error = %v", err)
+	}
+	if !got.Amount.Equal(want.Amount) || !got.Fee.Equal(want.Fee) {
+		t.Errorf("gob round trip = %+v, want %+v", got, want)
+	}
+}
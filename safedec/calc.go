@@ -0,0 +1,72 @@
+package safedec
+
+import "github.com/nduyhai/finarith/rounding"
+
+// Calc is a fluent calculation on a Decimal that carries the first error it encounters, so that a
+// multi-step calculation needs a single error check:
+//
+//	total, err := safedec.Start(price).MulD(quantity).DivD(units).Round(2, rounding.RoundHalfEven).Result()
+//
+// Once a step fails, the remaining steps are skipped and Result returns that error.
+// The zero value is a calculation on zero.
+type Calc struct {
+	value Decimal
+	err   error
+}
+
+// Start begins a calculation on the decimal value.
+func Start(d Decimal) Calc {
+	return Calc{value: d}
+}
+
+// AddD adds other to the value of the calculation.
+func (c Calc) AddD(other Decimal) Calc {
+	if c.err != nil {
+		return c
+	}
+	return Calc{value: c.value.Add(other)}
+}
+
+// SubD subtracts other from the value of the calculation.
+func (c Calc) SubD(other Decimal) Calc {
+	if c.err != nil {
+		return c
+	}
+	return Calc{value: c.value.Sub(other)}
+}
+
+// MulD multiplies the value of the calculation by other.
+func (c Calc) MulD(other Decimal) Calc {
+	if c.err != nil {
+		return c
+	}
+	return Calc{value: c.value.Mul(other)}
+}
+
+// DivD divides the value of the calculation by other, failing the calculation with
+// ErrDivideByZero if other is zero.
+func (c Calc) DivD(other Decimal) Calc {
+	if c.err != nil {
+		return c
+	}
+	value, err := c.value.Div(other)
+	return Calc{value: value, err: err}
+}
+
+// Round rounds the value of the calculation to the specified number of decimal places using the
+// specified rounding mode, failing the calculation if the rounding mode is invalid.
+func (c Calc) Round(places int32, mode rounding.Mode) Calc {
+	if c.err != nil {
+		return c
+	}
+	value, err := c.value.Round(places, mode)
+	return Calc{value: value, err: err}
+}
+
+// Result returns the value of the calculation, or the error of the first step that failed.
+func (c Calc) Result() (Decimal, error) {
+	if c.err != nil {
+		return Decimal{}, c.err
+	}
+	return c.value, nil
+}
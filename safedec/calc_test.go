@@ -0,0 +1,87 @@
+package safedec
+
+import (
+	"errors"
+	"testing"
+
+	finerrors "github.com/nduyhai/finarith/errors"
+	"github.com/nduyhai/finarith/rounding"
+)
+
+func TestCalc(t *testing.T) {
+	price, _ := NewFromString("19.99")
+	quantity := NewFromInt(3)
+	units := NewFromInt(7)
+	discount, _ := NewFromString("1.50")
+
+	tests := []struct {
+		name      string
+		calc      Calc
+		want      string
+		wantErr   bool
+		errorType error
+	}{
+		{
+			name: "successful chain",
+			calc: Start(price).MulD(quantity).SubD(discount).DivD(units).Round(2, rounding.RoundHalfEven),
+			want: "8.35",
+		},
+		{
+			name: "add and subtract",
+			calc: Start(price).AddD(discount).SubD(price),
+			want: "1.5",
+		},
+		{
+			name: "zero value calculation",
+			calc: Calc{}.AddD(price),
+			want: "19.99",
+		},
+		{
+			name:      "division by zero mid-chain",
+			calc:      Start(price).DivD(Zero()).MulD(quantity).Round(2, rounding.RoundHalfUp),
+			wantErr:   true,
+			errorType: finerrors.ErrDivideByZero,
+		},
+		{
+			name:      "first error is kept",
+			calc:      Start(price).Round(2, rounding.Mode(99)).DivD(Zero()),
+			wantErr:   true,
+			errorType: finerrors.ErrInvalidRounding,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.calc.Result()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Result() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				if !errors.Is(err, tt.errorType) {
+					t.Errorf("Result() error type = %v, want %v", err, tt.errorType)
+				}
+				return
+			}
+			if got.String() != tt.want {
+				t.Errorf("Result() = %v, want %v", got.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestCalc_DoesNotModifyEarlierSteps(t *testing.T) {
+	base := Start(NewFromInt(10))
+	failed := base.DivD(Zero())
+
+	got, err := base.MulD(NewFromInt(2)).Result()
+	if err != nil {
+		t.Errorf("Result() error = %v", err)
+	}
+	if got.String() != "20" {
+		t.Errorf("Result() = %v, want %v", got.String(), "20")
+	}
+	if _, err := failed.Result(); err == nil {
+		t.Errorf("Result() of failed branch expected error")
+	}
+}
@@ -0,0 +1,90 @@
+package safedec
+
+import (
+	"sync"
+	"time"
+
+	"github.com/nduyhai/finarith/rounding"
+)
+
+// JournalEntry records a single rounding operation.
+type JournalEntry struct {
+	// Original is the value before rounding.
+	Original Decimal
+
+	// Rounded is the value after rounding.
+	Rounded Decimal
+
+	// Residual is the amount removed by rounding (Original - Rounded).
+	Residual Decimal
+
+	// Mode is the rounding mode that was applied.
+	Mode rounding.Mode
+
+	// Places is the number of decimal places the value was rounded to.
+	Places int32
+
+	// Timestamp is the time the rounding operation was recorded.
+	Timestamp time.Time
+}
+
+// RoundingJournal wraps rounding operations and records each one for audit purposes.
+// Using a journal is optional; the plain Round method is unaffected.
+// The zero value is an empty journal ready to use. It is safe for concurrent use.
+type RoundingJournal struct {
+	mu      sync.Mutex
+	entries []JournalEntry
+	now     func() time.Time
+}
+
+// NewRoundingJournal creates a new, empty RoundingJournal.
+func NewRoundingJournal() *RoundingJournal {
+	return &RoundingJournal{now: time.Now}
+}
+
+// Round rounds the value to the specified number of decimal places using the specified
+// rounding mode and records the operation in the journal.
+// Returns an error if the rounding mode is invalid, in which case nothing is recorded.
+func (j *RoundingJournal) Round(value Decimal, places int32, mode rounding.Mode) (Decimal, error) {
+	rounded, err := value.Round(places, mode)
+	if err != nil {
+		return Decimal{}, err
+	}
+
+	now := j.now
+	if now == nil {
+		now = time.Now
+	}
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.entries = append(j.entries, JournalEntry{
+		Original:  value,
+		Rounded:   rounded,
+		Residual:  value.Sub(rounded),
+		Mode:      mode,
+		Places:    places,
+		Timestamp: now(),
+	})
+	return rounded, nil
+}
+
+// Entries returns a copy of the recorded entries in the order they were recorded.
+func (j *RoundingJournal) Entries() []JournalEntry {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	entries := make([]JournalEntry, len(j.entries))
+	copy(entries, j.entries)
+	return entries
+}
+
+// TotalResidual returns the sum of the residuals of all recorded entries.
+func (j *RoundingJournal) TotalResidual() Decimal {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	total := Zero()
+	for _, e := range j.entries {
+		total = total.Add(e.Residual)
+	}
+	return total
+}
@@ -0,0 +1,143 @@
+package safedec
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nduyhai/finarith/rounding"
+)
+
+func TestRoundingJournal_Round(t *testing.T) {
+	tests := []struct {
+		name             string
+		values           []string
+		mode             rounding.Mode
+		wantRounded      []string
+		wantTotalResidue string
+	}{
+		{
+			name:             "half up series",
+			values:           []string{"1.005", "2.344", "3.999"},
+			mode:             rounding.RoundHalfUp,
+			wantRounded:      []string{"1.01", "2.34", "4"},
+			wantTotalResidue: "-0.002",
+		},
+		{
+			name:             "round down series",
+			values:           []string{"10.129", "0.001", "-5.555"},
+			mode:             rounding.RoundDown,
+			wantRounded:      []string{"10.12", "0", "-5.55"},
+			wantTotalResidue: "0.005",
+		},
+		{
+			name:             "already rounded",
+			values:           []string{"1.10", "2.20"},
+			mode:             rounding.RoundHalfEven,
+			wantRounded:      []string{"1.1", "2.2"},
+			wantTotalResidue: "0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fixed := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+			j := NewRoundingJournal()
+			j.now = func() time.Time { return fixed }
+
+			for i, v := range tt.values {
+				d, _ := NewFromString(v)
+				got, err := j.Round(d, 2, tt.mode)
+				if err != nil {
+					t.Fatalf("Round() error = %v", err)
+				}
+				if got.String() != tt.wantRounded[i] {
+					t.Errorf("Round() = %v, want %v", got.String(), tt.wantRounded[i])
+				}
+			}
+
+			entries := j.Entries()
+			if len(entries) != len(tt.values) {
+				t.Fatalf("Entries() len = %v, want %v", len(entries), len(tt.values))
+			}
+			sum := Zero()
+			for i, e := range entries {
+				want, _ := NewFromString(tt.values[i])
+				if !e.Original.Equal(want) {
+					t.Errorf("Entries()[%d].Original = %v, want %v", i, e.Original, tt.values[i])
+				}
+				if !e.Original.Equal(e.Rounded.Add(e.Residual)) {
+					t.Errorf("Entries()[%d] Rounded + Residual = %v, want %v", i, e.Rounded.Add(e.Residual), e.Original)
+				}
+				if e.Mode != tt.mode || e.Places != 2 {
+					t.Errorf("Entries()[%d] mode/places = %v/%v, want %v/2", i, e.Mode, e.Places, tt.mode)
+				}
+				if !e.Timestamp.Equal(fixed) {
+					t.Errorf("Entries()[%d].Timestamp = %v, want %v", i, e.Timestamp, fixed)
+				}
+				sum = sum.Add(e.Residual)
+			}
+
+			if got := j.TotalResidual(); got.String() != tt.wantTotalResidue || !got.Equal(sum) {
+				t.Errorf("TotalResidual() = %v, want %v", got.String(), tt.wantTotalResidue)
+			}
+		})
+	}
+}
+
+func TestRoundingJournal_InvalidMode(t *testing.T) {
+	j := NewRoundingJournal()
+	if _, err := j.Round(NewFromInt(1), 2, rounding.Mode(99)); err == nil {
+		t.Errorf("Round() expected error for invalid rounding mode")
+	}
+	if len(j.Entries()) != 0 {
+		t.Errorf("Entries() len = %v, want 0", len(j.Entries()))
+	}
+}
+
+func TestRoundingJournal_ZeroValue(t *testing.T) {
+	var j RoundingJournal
+	value, _ := NewFromString("1.005")
+
+	before := time.Now()
+	rounded, err := j.Round(value, 2, rounding.RoundDown)
+	if err != nil {
+		t.Fatalf("Round() error = %v", err)
+	}
+	if rounded.String() != "1" {
+		t.Errorf("Round() = %v, want 1", rounded.String())
+	}
+
+	entries := j.Entries()
+	if len(entries) != 1 {
+		t.Fatalf("Entries() len = %v, want 1", len(entries))
+	}
+	if entries[0].Timestamp.Before(before) {
+		t.Errorf("Entries()[0].Timestamp = %v, want at or after %v", entries[0].Timestamp, before)
+	}
+	if got := j.TotalResidual(); got.String() != "0.005" {
+		t.Errorf("TotalResidual() = %v, want 0.005", got.String())
+	}
+}
+
+func TestRoundingJournal_Concurrent(t *testing.T) {
+	j := NewRoundingJournal()
+	value, _ := NewFromString("0.125")
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _ = j.Round(value, 2, rounding.RoundDown)
+		}()
+	}
+	wg.Wait()
+
+	if len(j.Entries()) != 50 {
+		t.Errorf("Entries() len = %v, want 50", len(j.Entries()))
+	}
+	if got := j.TotalResidual(); got.String() != "0.25" {
+		t.Errorf("TotalResidual() = %v, want 0.25", got.String())
+	}
+}
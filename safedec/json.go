@@ -0,0 +1,42 @@
+package safedec
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/nduyhai/finarith/errors"
+)
+
+// MarshalJSON implements json.Marshaler.
+// The decimal value is encoded as a JSON string, such as "123.45", so that no precision is lost
+// by consumers that decode JSON numbers as floating point.
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It accepts the decimal value either as a JSON string, such as "123.45", or as a JSON number.
+// A JSON null leaves the value unchanged.
+// Returns an error wrapping ErrInvalidFormat, along with the underlying parse error, if the input
+// is not a valid decimal.
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	s := string(data)
+	if len(data) > 0 && data[0] == '"' {
+		if err := json.Unmarshal(data, &s); err != nil {
+			return fmt.Errorf("%w: %s is not a decimal: %w", errors.ErrInvalidFormat, data, err)
+		}
+	}
+
+	parsed, err := NewFromString(s)
+	if err != nil {
+		return fmt.Errorf("%w: %q is not a decimal: %w", errors.ErrInvalidFormat, s, err)
+	}
+	*d = parsed
+	return nil
+}
@@ -0,0 +1,164 @@
+package safedec
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	finerrors "github.com/nduyhai/finarith/errors"
+)
+
+func TestDecimal_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  string
+	}{
+		{
+			name:  "positive decimal",
+			value: "123.45",
+			want:  `"123.45"`,
+		},
+		{
+			name:  "negative decimal",
+			value: "-0.001",
+			want:  `"-0.001"`,
+		},
+		{
+			name:  "integer",
+			value: "42",
+			want:  `"42"`,
+		},
+		{
+			name:  "zero",
+			value: "0",
+			want:  `"0"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, _ := NewFromString(tt.value)
+			got, err := json.Marshal(d)
+			if err != nil {
+				t.Fatalf("MarshalJSON() error = %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("MarshalJSON() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimal_MarshalJSON_Embedded(t *testing.T) {
+	type payment struct {
+		Amount Decimal  `json:"amount"`
+		Fee    *Decimal `json:"fee,omitempty"`
+	}
+
+	amount, _ := NewFromString("19.99")
+	got, err := json.Marshal(payment{Amount: amount})
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	if want := `{"amount":"19.99"}`; string(got) != want {
+		t.Errorf("json.Marshal() = %s, want %s", got, want)
+	}
+
+	var decoded payment
+	if err := json.Unmarshal(got, &decoded); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if !decoded.Amount.Equal(amount) {
+		t.Errorf("json.Unmarshal() Amount = %v, want %v", decoded.Amount, amount)
+	}
+	if decoded.Fee != nil {
+		t.Errorf("json.Unmarshal() Fee = %v, want nil", decoded.Fee)
+	}
+}
+
+func TestDecimal_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr bool
+		cause   error
+	}{
+		{
+			name:  "string",
+			input: `"123.45"`,
+			want:  "123.45",
+		},
+		{
+			name:  "number",
+			input: `123.45`,
+			want:  "123.45",
+		},
+		{
+			name:  "negative number",
+			input: `-7`,
+			want:  "-7",
+		},
+		{
+			name:  "exponent number",
+			input: `1.5e3`,
+			want:  "1500",
+		},
+		{
+			name:  "precision beyond float64",
+			input: `"0.12345678901234567890123"`,
+			want:  "0.12345678901234567890123",
+		},
+		{
+			name:  "null leaves value unchanged",
+			input: `null`,
+			want:  "1",
+		},
+		{
+			name:    "invalid string",
+			input:   `"abc"`,
+			wantErr: true,
+		},
+		{
+			name:    "empty string",
+			input:   `""`,
+			wantErr: true,
+		},
+		{
+			name:    "boolean",
+			input:   `true`,
+			wantErr: true,
+		},
+		{
+			name:    "too long keeps the parse error",
+			input:   `"` + strings.Repeat("9", DefaultMaxLength+1) + `"`,
+			wantErr: true,
+			cause:   finerrors.ErrInputTooLong,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := One()
+			err := d.UnmarshalJSON([]byte(tt.input))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UnmarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				if !errors.Is(err, finerrors.ErrInvalidFormat) {
+					t.Errorf("UnmarshalJSON() error is not ErrInvalidFormat: %v", err)
+				}
+				if tt.cause != nil && !errors.Is(err, tt.cause) {
+					t.Errorf("UnmarshalJSON() error = %v, want wrapped %v", err, tt.cause)
+				}
+				return
+			}
+			if d.String() != tt.want {
+				t.Errorf("UnmarshalJSON() = %v, want %v", d.String(), tt.want)
+			}
+		})
+	}
+}
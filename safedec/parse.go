@@ -0,0 +1,402 @@
+package safedec
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/nduyhai/finarith/errors"
+)
+
+// DefaultMaxLength is the maximum input length accepted when ParseOptions.MaxLength is zero.
+const DefaultMaxLength = 100
+
+// ParseOptions configures how strings are parsed into Decimal values.
+type ParseOptions struct {
+	// MaxLength is the maximum accepted input length in bytes. Longer inputs are rejected before
+	// parsing, so untrusted input cannot force large allocations. Zero means DefaultMaxLength and
+	// a negative value disables the check.
+	MaxLength int
+
+	// Parser is the parser to use. Nil means the default parser.
+	Parser DecimalParser
+}
+
+// DecimalParser converts a string representation into a decimal.Decimal value.
+// Implementations can be used to restrict the accepted input format or to mock parsing in tests.
+type DecimalParser interface {
+	Parse(s string) (decimal.Decimal, error)
+}
+
+// shopspringParser is the DecimalParser backed by decimal.NewFromString.
+type shopspringParser struct{}
+
+// Parse parses the string using decimal.NewFromString.
+// Plain integer strings that fit in an int64 take a faster path with an identical result.
+func (shopspringParser) Parse(s string) (decimal.Decimal, error) {
+	if n, ok := parseSmallInteger(s); ok {
+		return decimal.NewFromInt(n), nil
+	}
+	if isInteger(s) {
+		if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return decimal.NewFromInt(n), nil
+		}
+	}
+	return decimal.NewFromString(s)
+}
+
+// maxSmallIntegerDigits is the number of digits that always fits in an int64 without overflow.
+const maxSmallIntegerDigits = 18
+
+// parseSmallInteger parses s in a single pass if it is an optional leading minus sign followed by
+// one to maxSmallIntegerDigits ASCII digits, reporting false for any other input.
+func parseSmallInteger(s string) (int64, bool) {
+	digits := s
+	if len(digits) > 0 && digits[0] == '-' {
+		digits = digits[1:]
+	}
+	if len(digits) == 0 || len(digits) > maxSmallIntegerDigits {
+		return 0, false
+	}
+
+	var n int64
+	for i := 0; i < len(digits); i++ {
+		c := digits[i]
+		if c < '0' || c > '9' {
+			return 0, false
+		}
+		n = n*10 + int64(c-'0')
+	}
+	if len(digits) < len(s) {
+		n = -n
+	}
+	return n, true
+}
+
+// isInteger reports whether s consists of an optional leading minus sign followed by one or more ASCII digits.
+func isInteger(s string) bool {
+	if len(s) > 0 && s[0] == '-' {
+		s = s[1:]
+	}
+	if len(s) == 0 {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// defaultParser is the parser used by NewFromString.
+var defaultParser DecimalParser = shopspringParser{}
+
+// SetDefaultParser replaces the parser used by NewFromString.
+// Passing nil restores the shopspring/decimal parser.
+// It should be called during program initialization, as it is not safe for concurrent use with parsing.
+func SetDefaultParser(p DecimalParser) {
+	if p == nil {
+		p = shopspringParser{}
+	}
+	defaultParser = p
+}
+
+// NewFromStringWith creates a new Decimal from a string representation using the given parser.
+// Inputs longer than DefaultMaxLength are rejected.
+func NewFromStringWith(value string, parser DecimalParser) (Decimal, error) {
+	return NewFromStringWithOptions(value, ParseOptions{Parser: parser})
+}
+
+// NewFromStringWithOptions creates a new Decimal from a string representation using the given options.
+// Returns an InputTooLongError if the input exceeds the maximum length.
+func NewFromStringWithOptions(value string, opts ParseOptions) (Decimal, error) {
+	maxLength := opts.MaxLength
+	if maxLength == 0 {
+		maxLength = DefaultMaxLength
+	}
+	if maxLength > 0 && len(value) > maxLength {
+		return Decimal{}, errors.NewInputTooLongError(len(value), maxLength)
+	}
+
+	parser := opts.Parser
+	if parser == nil {
+		parser = defaultParser
+	}
+
+	d, err := parser.Parse(value)
+	if err != nil {
+		return Decimal{}, err
+	}
+	return Decimal{value: d}, nil
+}
+
+// NewFromCurrencyString creates a new Decimal from a currency-formatted string such as "$1,234.56"
+// or "€10,50", returning the amount and the currency symbol found before or after the number.
+// Either "," or "." is accepted as the decimal separator, with the other used for digit grouping.
+// Returns an error wrapping ErrInvalidFormat if the string contains no number, has symbols on both
+// sides, or uses its separators ambiguously (e.g. "1,234" could mean 1234 or 1.234).
+func NewFromCurrencyString(s string) (amount Decimal, currencySymbol string, err error) {
+	s = strings.TrimSpace(s)
+
+	// Allow a minus sign before the symbol, as in "-$5.00"
+	sign := ""
+	if strings.HasPrefix(s, "-") {
+		sign = "-"
+		s = strings.TrimSpace(s[1:])
+	}
+
+	start := strings.IndexFunc(s, isNumberRune)
+	if start < 0 {
+		return Decimal{}, "", fmt.Errorf("%w: no amount in %q", errors.ErrInvalidFormat, s)
+	}
+	end := strings.LastIndexFunc(s, isNumberRune) + 1
+
+	prefix := strings.TrimSpace(s[:start])
+	suffix := strings.TrimSpace(s[end:])
+	if prefix != "" && suffix != "" {
+		return Decimal{}, "", fmt.Errorf("%w: currency symbol on both sides of %q", errors.ErrInvalidFormat, s)
+	}
+
+	number := s[start:end]
+	if strings.HasPrefix(number, "-") {
+		if sign != "" {
+			return Decimal{}, "", fmt.Errorf("%w: repeated sign in %q", errors.ErrInvalidFormat, s)
+		}
+		sign = "-"
+		number = number[1:]
+	}
+
+	normalized, err := normalizeSeparators(number)
+	if err != nil {
+		return Decimal{}, "", err
+	}
+
+	amount, err = NewFromString(sign + normalized)
+	if err != nil {
+		return Decimal{}, "", err
+	}
+	return amount, prefix + suffix, nil
+}
+
+// localeDecimalSeparators maps lower-case language subtags and locale tags to the decimal
+// separator they use. The group separator is taken to be the other of "," and ".", unless
+// localeGroupSeparators lists the tag.
+// Regions are listed explicitly rather than inferred from their language, since some differ
+// from it, such as Swiss German using "." where German uses ",".
+var localeDecimalSeparators = map[string]string{
+	"en":    ".",
+	"en-au": ".",
+	"en-ca": ".",
+	"en-gb": ".",
+	"en-ie": ".",
+	"en-in": ".",
+	"en-nz": ".",
+	"en-sg": ".",
+	"en-us": ".",
+	"ja":    ".",
+	"ja-jp": ".",
+	"ko":    ".",
+	"ko-kr": ".",
+	"zh":    ".",
+	"zh-cn": ".",
+	"zh-hk": ".",
+	"zh-tw": ".",
+	"de-ch": ".",
+	"de-li": ".",
+	"it-ch": ".",
+	"es-mx": ".",
+	"es-us": ".",
+	"da":    ",",
+	"da-dk": ",",
+	"de":    ",",
+	"de-at": ",",
+	"de-de": ",",
+	"es":    ",",
+	"es-ar": ",",
+	"es-es": ",",
+	"fi":    ",",
+	"fi-fi": ",",
+	"fr":    ",",
+	"fr-be": ",",
+	"fr-ca": ",",
+	"fr-fr": ",",
+	"id":    ",",
+	"id-id": ",",
+	"it":    ",",
+	"it-it": ",",
+	"nl":    ",",
+	"nl-be": ",",
+	"nl-nl": ",",
+	"pl":    ",",
+	"pl-pl": ",",
+	"pt":    ",",
+	"pt-br": ",",
+	"pt-pt": ",",
+	"ru":    ",",
+	"ru-ru": ",",
+	"sv":    ",",
+	"sv-fi": ",",
+	"sv-se": ",",
+	"tr":    ",",
+	"tr-tr": ",",
+}
+
+// localeGroupSeparators maps the locale tags whose group separator is not the other of "," and
+// "." to the group separator they use, such as the apostrophe in Swiss formatting (1'234.56).
+var localeGroupSeparators = map[string]string{
+	"de-ch": "'",
+	"de-li": "'",
+	"it-ch": "'",
+}
+
+// NewFromFormattedString creates a new Decimal from a number formatted for display, such as
+// "1,234.56" or "€ 1.234,56". Currency symbols and whitespace are removed, and the separators are
+// interpreted according to locale, a language such as "de" or a tag such as "en-US" or "de-CH"
+// matched case-insensitively. Swiss tags group digits with an apostrophe, as in "1'234.56", and
+// the typographic apostrophe is accepted in its place. A tag whose region is not listed is
+// rejected rather than guessed from its language, which could misread the separators. An empty
+// locale detects the separators as NewFromCurrencyString does, which rejects ambiguous strings
+// such as "1.234" that an explicit locale resolves.
+// Returns an error wrapping ErrInvalidArgument if the locale is not recognized, or an error
+// wrapping ErrInvalidFormat if the string is not a well-formed number for the locale.
+func NewFromFormattedString(s, locale string) (Decimal, error) {
+	cleaned := strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) || unicode.Is(unicode.Sc, r) {
+			return -1
+		}
+		if r == '’' {
+			return '\''
+		}
+		return r
+	}, s)
+
+	sign := ""
+	if strings.HasPrefix(cleaned, "-") {
+		sign = "-"
+		cleaned = cleaned[1:]
+	}
+	if cleaned == "" || strings.Trim(cleaned, "0123456789,.'") != "" {
+		return Decimal{}, fmt.Errorf("%w: not a formatted number %q", errors.ErrInvalidFormat, s)
+	}
+
+	var normalized string
+	var err error
+	if locale == "" {
+		normalized, err = normalizeSeparators(cleaned)
+	} else {
+		decimalSep, groupSep, ok := lookupSeparators(locale)
+		if !ok {
+			return Decimal{}, fmt.Errorf("%w: unknown locale %q", errors.ErrInvalidArgument, locale)
+		}
+		if strings.Trim(cleaned, "0123456789"+decimalSep+groupSep) != "" {
+			return Decimal{}, fmt.Errorf("%w: unexpected separator in %q for locale %q", errors.ErrInvalidFormat, s, locale)
+		}
+		normalized, err = joinNumber(cleaned, decimalSep, groupSep)
+	}
+	if err != nil {
+		return Decimal{}, err
+	}
+
+	return NewFromString(sign + normalized)
+}
+
+// lookupSeparators returns the decimal and group separators for the locale, accepting "_" in
+// place of "-". Only listed tags are recognized; a region is never resolved through its language
+// subtag.
+func lookupSeparators(locale string) (decimalSep, groupSep string, ok bool) {
+	tag := strings.ToLower(strings.ReplaceAll(locale, "_", "-"))
+	decimalSep, ok = localeDecimalSeparators[tag]
+	if !ok {
+		return "", "", false
+	}
+	if sep, listed := localeGroupSeparators[tag]; listed {
+		return decimalSep, sep, true
+	}
+	if decimalSep == "," {
+		return decimalSep, ".", true
+	}
+	return decimalSep, ",", true
+}
+
+// isNumberRune reports whether r can be part of a formatted number.
+func isNumberRune(r rune) bool {
+	return (r >= '0' && r <= '9') || r == '-' || r == ',' || r == '.'
+}
+
+// normalizeSeparators converts an unsigned number using "," and "." as decimal and group
+// separators into the plain form accepted by NewFromString.
+func normalizeSeparators(number string) (string, error) {
+	if strings.Trim(number, "0123456789,.") != "" {
+		return "", fmt.Errorf("%w: unexpected character in %q", errors.ErrInvalidFormat, number)
+	}
+
+	lastComma := strings.LastIndex(number, ",")
+	lastDot := strings.LastIndex(number, ".")
+
+	var decimalSep, groupSep string
+	switch {
+	case lastComma >= 0 && lastDot >= 0:
+		// With both present, whichever comes last separates the fraction
+		if lastComma > lastDot {
+			decimalSep, groupSep = ",", "."
+		} else {
+			decimalSep, groupSep = ".", ","
+		}
+	case lastComma >= 0 || lastDot >= 0:
+		sep := ","
+		if lastDot >= 0 {
+			sep = "."
+		}
+		if strings.Count(number, sep) > 1 {
+			groupSep = sep
+		} else if len(number)-strings.Index(number, sep)-1 == 3 {
+			return "", fmt.Errorf("%w: ambiguous separator in %q", errors.ErrInvalidFormat, number)
+		} else {
+			decimalSep = sep
+		}
+	}
+
+	return joinNumber(number, decimalSep, groupSep)
+}
+
+// joinNumber converts an unsigned number using the given decimal and group separators into the
+// plain form accepted by NewFromString. Either separator may be empty if it is not used, and group
+// separators are optional but must be placed every three digits when present.
+func joinNumber(number, decimalSep, groupSep string) (string, error) {
+	integerPart, fractionPart := number, ""
+	if decimalSep != "" {
+		if idx := strings.Index(number, decimalSep); idx >= 0 {
+			if idx != strings.LastIndex(number, decimalSep) {
+				return "", fmt.Errorf("%w: repeated decimal separator in %q", errors.ErrInvalidFormat, number)
+			}
+			integerPart, fractionPart = number[:idx], number[idx+1:]
+		}
+	}
+
+	if strings.Trim(fractionPart, "0123456789") != "" {
+		return "", fmt.Errorf("%w: separator in the fraction of %q", errors.ErrInvalidFormat, number)
+	}
+
+	if groupSep != "" && strings.Contains(integerPart, groupSep) {
+		groups := strings.Split(integerPart, groupSep)
+		for i, group := range groups {
+			if (i == 0 && (len(group) == 0 || len(group) > 3)) || (i > 0 && len(group) != 3) {
+				return "", fmt.Errorf("%w: misplaced group separator in %q", errors.ErrInvalidFormat, number)
+			}
+		}
+		integerPart = strings.Join(groups, "")
+	}
+
+	if integerPart == "" || (decimalSep != "" && strings.Contains(number, decimalSep) && fractionPart == "") {
+		return "", fmt.Errorf("%w: incomplete number %q", errors.ErrInvalidFormat, number)
+	}
+
+	if fractionPart == "" {
+		return integerPart, nil
+	}
+	return integerPart + "." + fractionPart, nil
+}
@@ -0,0 +1,582 @@
+package safedec
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/shopspring/decimal"
+
+	finerrors "github.com/nduyhai/finarith/errors"
+)
+
+// integerParser is a DecimalParser that only accepts integer strings.
+type integerParser struct{}
+
+func (integerParser) Parse(s string) (decimal.Decimal, error) {
+	if strings.Contains(s, ".") {
+		return decimal.Decimal{}, fmt.Errorf("fractional value not allowed: %q", s)
+	}
+	return decimal.NewFromString(s)
+}
+
+func TestNewFromStringWith(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:  "integer",
+			value: "100",
+			want:  "100",
+		},
+		{
+			name:  "negative integer",
+			value: "-25",
+			want:  "-25",
+		},
+		{
+			name:    "fractional value",
+			value:   "10.50",
+			wantErr: true,
+		},
+		{
+			name:    "invalid value",
+			value:   "abc",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewFromStringWith(tt.value, integerParser{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewFromStringWith() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && got.String() != tt.want {
+				t.Errorf("NewFromStringWith() = %v, want %v", got.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestSetDefaultParser(t *testing.T) {
+	SetDefaultParser(integerParser{})
+	defer SetDefaultParser(nil)
+
+	if _, err := NewFromString("10.50"); err == nil {
+		t.Errorf("NewFromString() with integer parser accepted a fractional value")
+	}
+	if got, err := NewFromString("10"); err != nil || got.String() != "10" {
+		t.Errorf("NewFromString() with integer parser = %v, %v, want 10", got.String(), err)
+	}
+
+	SetDefaultParser(nil)
+	if got, err := NewFromString("10.50"); err != nil || got.String() != "10.5" {
+		t.Errorf("NewFromString() after reset = %v, %v, want 10.5", got.String(), err)
+	}
+}
+
+func TestIsInteger(t *testing.T) {
+	tests := []struct {
+		value string
+		want  bool
+	}{
+		{value: "100", want: true},
+		{value: "-25", want: true},
+		{value: "0", want: true},
+		{value: "007", want: true},
+		{value: "", want: false},
+		{value: "-", want: false},
+		{value: "+5", want: false},
+		{value: "10.5", want: false},
+		{value: "1e3", want: false},
+		{value: "--1", want: false},
+		{value: " 1", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			if got := isInteger(tt.value); got != tt.want {
+				t.Errorf("isInteger(%q) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseSmallInteger(t *testing.T) {
+	tests := []struct {
+		value  string
+		want   int64
+		wantOK bool
+	}{
+		{value: "100", want: 100, wantOK: true},
+		{value: "-25", want: -25, wantOK: true},
+		{value: "0", want: 0, wantOK: true},
+		{value: "-0", want: 0, wantOK: true},
+		{value: "007", want: 7, wantOK: true},
+		{value: "999999999999999999", want: 999999999999999999, wantOK: true},
+		{value: "-999999999999999999", want: -999999999999999999, wantOK: true},
+		{value: "1000000000000000000", wantOK: false},
+		{value: "", wantOK: false},
+		{value: "-", wantOK: false},
+		{value: "+5", wantOK: false},
+		{value: "10.5", wantOK: false},
+		{value: "1e3", wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, ok := parseSmallInteger(tt.value)
+			if ok != tt.wantOK || got != tt.want {
+				t.Errorf("parseSmallInteger(%q) = %v, %v, want %v, %v", tt.value, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func FuzzNewFromStringIntegerFastPath(f *testing.F) {
+	for _, seed := range []string{"0", "-0", "1", "-1", "007", "5000", "9223372036854775807", "-9223372036854775808", "9223372036854775808", "99999999999999999999", strings.Repeat("9", DefaultMaxLength+1)} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		if !isInteger(s) {
+			return
+		}
+
+		got, err := NewFromString(s)
+		if len(s) > DefaultMaxLength {
+			if !errors.Is(err, finerrors.ErrInputTooLong) {
+				t.Fatalf("NewFromString() of %d bytes error = %v, want ErrInputTooLong", len(s), err)
+			}
+			return
+		}
+		if err != nil {
+			t.Fatalf("NewFromString(%q) error = %v", s, err)
+		}
+		want, err := decimal.NewFromString(s)
+		if err != nil {
+			t.Fatalf("decimal.NewFromString(%q) error = %v", s, err)
+		}
+
+		if !got.Value().Equal(want) || got.Value().Exponent() != want.Exponent() || got.String() != want.String() {
+			t.Errorf("NewFromString(%q) = %v (exp %d), want %v (exp %d)", s, got, got.Value().Exponent(), want, want.Exponent())
+		}
+	})
+}
+
+func BenchmarkNewFromString(b *testing.B) {
+	b.Run("integer fast path", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = NewFromString("5000")
+		}
+	})
+	b.Run("integer shopspring", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = decimal.NewFromString("5000")
+		}
+	})
+	b.Run("fractional", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = NewFromString("5000.25")
+		}
+	})
+}
+
+func TestNewFromStringWithOptions(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     string
+		opts      ParseOptions
+		want      string
+		wantErr   bool
+		errorType error
+	}{
+		{
+			name:  "default maximum length",
+			value: strings.Repeat("9", DefaultMaxLength),
+			opts:  ParseOptions{},
+			want:  strings.Repeat("9", DefaultMaxLength),
+		},
+		{
+			name:      "exceeds default maximum length",
+			value:     strings.Repeat("9", DefaultMaxLength+1),
+			opts:      ParseOptions{},
+			wantErr:   true,
+			errorType: finerrors.ErrInputTooLong,
+		},
+		{
+			name:      "exceeds custom maximum length",
+			value:     "12345.67",
+			opts:      ParseOptions{MaxLength: 5},
+			wantErr:   true,
+			errorType: finerrors.ErrInputTooLong,
+		},
+		{
+			name:  "check disabled",
+			value: strings.Repeat("9", 200),
+			opts:  ParseOptions{MaxLength: -1},
+			want:  strings.Repeat("9", 200),
+		},
+		{
+			name:    "custom parser",
+			value:   "10.50",
+			opts:    ParseOptions{Parser: integerParser{}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewFromStringWithOptions(tt.value, tt.opts)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewFromStringWithOptions() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil && tt.errorType != nil && !errors.Is(err, tt.errorType) {
+				t.Errorf("NewFromStringWithOptions() error type = %v, want %v", err, tt.errorType)
+			}
+			if !tt.wantErr && got.String() != tt.want {
+				t.Errorf("NewFromStringWithOptions() = %v, want %v", got.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestNewFromString_TooLongAllocations(t *testing.T) {
+	long := strings.Repeat("9", 10000)
+
+	allocs := testing.AllocsPerRun(100, func() {
+		_, _ = NewFromString(long)
+	})
+
+	// Only the error value may be allocated; the input must never reach the parser
+	if allocs > 1 {
+		t.Errorf("NewFromString() of a %d byte input allocated %v times, want at most 1", len(long), allocs)
+	}
+}
+
+func FuzzNewFromStringMaxLength(f *testing.F) {
+	for _, n := range []int{1, 100, 101, 10000} {
+		f.Add(strings.Repeat("1", n))
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		_, err := NewFromString(s)
+
+		var tooLong *finerrors.InputTooLongError
+		if len(s) > DefaultMaxLength {
+			if !errors.As(err, &tooLong) {
+				t.Fatalf("NewFromString() of %d bytes error = %v, want InputTooLongError", len(s), err)
+			}
+			if tooLong.Length != len(s) || tooLong.Max != DefaultMaxLength {
+				t.Errorf("NewFromString() error = %+v, want Length %d and Max %d", tooLong, len(s), DefaultMaxLength)
+			}
+			return
+		}
+
+		if errors.Is(err, finerrors.ErrInputTooLong) {
+			t.Errorf("NewFromString() of %d bytes returned ErrInputTooLong", len(s))
+		}
+	})
+}
+
+func TestNewFromCurrencyString(t *testing.T) {
+	tests := []struct {
+		name       string
+		value      string
+		want       string
+		wantSymbol string
+		wantErr    bool
+	}{
+		{
+			name:       "dollar with group separator",
+			value:      "$1,234.56",
+			want:       "1234.56",
+			wantSymbol: "$",
+		},
+		{
+			name:       "euro with decimal comma",
+			value:      "€10,50",
+			want:       "10.5",
+			wantSymbol: "€",
+		},
+		{
+			name:       "european grouping",
+			value:      "1.234.567,89 €",
+			want:       "1234567.89",
+			wantSymbol: "€",
+		},
+		{
+			name:       "multiple groups without fraction",
+			value:      "£1,234,567",
+			want:       "1234567",
+			wantSymbol: "£",
+		},
+		{
+			name:       "trailing code",
+			value:      "99.5 CHF",
+			want:       "99.5",
+			wantSymbol: "CHF",
+		},
+		{
+			name:       "negative before symbol",
+			value:      "-$5.00",
+			want:       "-5",
+			wantSymbol: "$",
+		},
+		{
+			name:       "negative after symbol",
+			value:      "$-5.25",
+			want:       "-5.25",
+			wantSymbol: "$",
+		},
+		{
+			name:       "no symbol",
+			value:      "42",
+			want:       "42",
+			wantSymbol: "",
+		},
+		{
+			name:    "ambiguous separator",
+			value:   "$1,234",
+			wantErr: true,
+		},
+		{
+			name:    "misplaced group separator",
+			value:   "$1,23,4.00",
+			wantErr: true,
+		},
+		{
+			name:    "repeated decimal separator",
+			value:   "€1,234,5",
+			wantErr: true,
+		},
+		{
+			name:    "symbols on both sides",
+			value:   "$10.00 USD",
+			wantErr: true,
+		},
+		{
+			name:    "no amount",
+			value:   "$",
+			wantErr: true,
+		},
+		{
+			name:    "repeated sign",
+			value:   "-$-5.00",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, symbol, err := NewFromCurrencyString(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewFromCurrencyString() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				if !errors.Is(err, finerrors.ErrInvalidFormat) {
+					t.Errorf("NewFromCurrencyString() error type = %v, want %v", err, finerrors.ErrInvalidFormat)
+				}
+				return
+			}
+			if got.String() != tt.want {
+				t.Errorf("NewFromCurrencyString() amount = %v, want %v", got.String(), tt.want)
+			}
+			if symbol != tt.wantSymbol {
+				t.Errorf("NewFromCurrencyString() symbol = %q, want %q", symbol, tt.wantSymbol)
+			}
+		})
+	}
+}
+
+func TestNewFromFormattedString(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     string
+		locale    string
+		want      string
+		wantErr   bool
+		errorType error
+	}{
+		{
+			name:   "US grouping",
+			value:  "1,234.56",
+			locale: "en-US",
+			want:   "1234.56",
+		},
+		{
+			name:   "German grouping with symbol",
+			value:  "€ 1.234,56",
+			locale: "de-DE",
+			want:   "1234.56",
+		},
+		{
+			name:   "trailing symbol and no-break space",
+			value:  "1.234.567,89\u00a0€",
+			locale: "de-DE",
+			want:   "1234567.89",
+		},
+		{
+			name:   "grouping is optional",
+			value:  "1234,5",
+			locale: "fr-FR",
+			want:   "1234.5",
+		},
+		{
+			name:   "French narrow space grouping",
+			value:  "1\u202f234,56 €",
+			locale: "fr-FR",
+			want:   "1234.56",
+		},
+		{
+			name:   "ambiguous string with English locale",
+			value:  "1.234",
+			locale: "en-US",
+			want:   "1.234",
+		},
+		{
+			name:   "ambiguous string with German locale",
+			value:  "1.234",
+			locale: "de-DE",
+			want:   "1234",
+		},
+		{
+			name:   "region override",
+			value:  "$1,234.50",
+			locale: "es-MX",
+			want:   "1234.5",
+		},
+		{
+			name:   "underscore separator",
+			value:  "12,5",
+			locale: "it_IT",
+			want:   "12.5",
+		},
+		{
+			name:   "language only",
+			value:  "1.234,5",
+			locale: "de",
+			want:   "1234.5",
+		},
+		{
+			name:   "Swiss German differs from German",
+			value:  "1.234",
+			locale: "de-CH",
+			want:   "1.234",
+		},
+		{
+			name:   "Swiss apostrophe grouping",
+			value:  "1'234.56",
+			locale: "de-CH",
+			want:   "1234.56",
+		},
+		{
+			name:   "Swiss typographic apostrophe grouping",
+			value:  "1’234’567.5",
+			locale: "it-CH",
+			want:   "1234567.5",
+		},
+		{
+			name:   "negative",
+			value:  "-$ 1,000.00",
+			locale: "en-US",
+			want:   "-1000",
+		},
+		{
+			name:   "detected without locale",
+			value:  "£1,234.56",
+			locale: "",
+			want:   "1234.56",
+		},
+		{
+			name:      "ambiguous without locale",
+			value:     "1.234",
+			locale:    "",
+			wantErr:   true,
+			errorType: finerrors.ErrInvalidFormat,
+		},
+		{
+			name:      "wrong locale for the format",
+			value:     "1.234,56",
+			locale:    "en-US",
+			wantErr:   true,
+			errorType: finerrors.ErrInvalidFormat,
+		},
+		{
+			name:      "comma grouping with Swiss locale",
+			value:     "1,234.56",
+			locale:    "de-CH",
+			wantErr:   true,
+			errorType: finerrors.ErrInvalidFormat,
+		},
+		{
+			name:      "apostrophe grouping with German locale",
+			value:     "1'234,56",
+			locale:    "de-DE",
+			wantErr:   true,
+			errorType: finerrors.ErrInvalidFormat,
+		},
+		{
+			name:      "misplaced group separator",
+			value:     "12,34.56",
+			locale:    "en-US",
+			wantErr:   true,
+			errorType: finerrors.ErrInvalidFormat,
+		},
+		{
+			name:      "letters",
+			value:     "12.50 USD",
+			locale:    "en-US",
+			wantErr:   true,
+			errorType: finerrors.ErrInvalidFormat,
+		},
+		{
+			name:      "no digits",
+			value:     "€",
+			locale:    "de-DE",
+			wantErr:   true,
+			errorType: finerrors.ErrInvalidFormat,
+		},
+		{
+			name:      "unknown locale",
+			value:     "1,234.56",
+			locale:    "xx-YY",
+			wantErr:   true,
+			errorType: finerrors.ErrInvalidArgument,
+		},
+		{
+			name:      "unlisted region of a known language",
+			value:     "1.234",
+			locale:    "de-LU",
+			wantErr:   true,
+			errorType: finerrors.ErrInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewFromFormattedString(tt.value, tt.locale)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewFromFormattedString() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				if !errors.Is(err, tt.errorType) {
+					t.Errorf("NewFromFormattedString() error type = %v, want %v", err, tt.errorType)
+				}
+				return
+			}
+			if got.String() != tt.want {
+				t.Errorf("NewFromFormattedString() = %v, want %v", got.String(), tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package safedec
+
+import (
+	"github.com/shopspring/decimal"
+
+	"github.com/nduyhai/finarith/errors"
+	"github.com/nduyhai/finarith/rounding"
+)
+
+// ClampPercent returns the decimal value clamped to the percentage range [0, 100].
+func (d Decimal) ClampPercent() Decimal {
+	return MinValue(MaxValue(d, Zero()), NewFromInt(100))
+}
+
+// AsFraction converts a percentage into a fraction by dividing it by 100 (e.g. 25 becomes 0.25).
+func (d Decimal) AsFraction() Decimal {
+	return Decimal{value: d.value.Shift(-2)}
+}
+
+// Percentage returns percent percent of the decimal value, computed exactly as d * percent / 100
+// without rounding, so a 7.5% fee on 20.00 is 1.5. Use PercentageUnchecked for percentages
+// outside [0, 100], such as markups.
+// Returns ErrNegativeValue if percent is negative, or an error wrapping ErrExceedsLimit if
+// percent is greater than 100.
+func (d Decimal) Percentage(percent Decimal) (Decimal, error) {
+	if percent.IsNegative() {
+		return Decimal{}, errors.ErrNegativeValue
+	}
+	hundred := NewFromInt(100)
+	if percent.GreaterThan(hundred) {
+		return Decimal{}, errors.NewLimitError(percent.String(), hundred.String(), "percentage")
+	}
+	return d.PercentageUnchecked(percent), nil
+}
+
+// PercentageUnchecked returns percent percent of the decimal value like Percentage, but accepts
+// any percentage, including negative ones and ones above 100.
+func (d Decimal) PercentageUnchecked(percent Decimal) Decimal {
+	return d.Mul(percent.AsFraction())
+}
+
+// PercentageOf returns the percentage of total that the decimal value represents, computed as
+// d / total * 100 without rounding, so 342 of 1000 is 34.2. It is the inverse of Percentage.
+// Returns ErrDivideByZero if total is zero.
+func (d Decimal) PercentageOf(total Decimal) (Decimal, error) {
+	return d.Mul(NewFromInt(100)).Div(total)
+}
+
+// PercentOf returns the decimal value, taken as a percentage, of whole, computed as whole * d / 100
+// (e.g. 15 of 200 is 30). The result is exact, carrying two more decimal places than the product of
+// the operands, rather than being cut off at a hidden division precision; use PercentOfRound to
+// round it. The computation itself cannot fail, and the error result is always nil.
+func (d Decimal) PercentOf(whole Decimal) (Decimal, error) {
+	return whole.PercentageUnchecked(d), nil
+}
+
+// PercentOfRound returns the decimal value, taken as a percentage, of whole like PercentOf, rounded
+// to the specified number of decimal places using the specified rounding mode.
+// Returns an error if the rounding mode is invalid.
+func (d Decimal) PercentOfRound(whole Decimal, places int32, mode rounding.Mode) (Decimal, error) {
+	result, err := d.PercentOf(whole)
+	if err != nil {
+		return Decimal{}, err
+	}
+	return result.Round(places, mode)
+}
+
+// Percentage is a percentage rate, such as 15 for 15%, kept distinct from the amounts it applies to.
+type Percentage struct {
+	value Decimal
+}
+
+// NewPercentage creates a new Percentage from a value in percentage points.
+func NewPercentage(value Decimal) Percentage {
+	return Percentage{value: value}
+}
+
+// Decimal returns the percentage in percentage points, e.g. 15 for 15%.
+func (p Percentage) Decimal() Decimal {
+	return p.value
+}
+
+// Of returns the percentage of amount exactly, as PercentOf does.
+func (p Percentage) Of(amount Decimal) Decimal {
+	return amount.PercentageUnchecked(p.value)
+}
+
+// String returns the string representation of the percentage, e.g. "15%".
+func (p Percentage) String() string {
+	return p.value.String() + "%"
+}
+
+// PercentBreakdown returns each value's percentage of the sum of all values, rounded to the
+// specified number of decimal places using the specified rounding mode.
+// The rounded percentages are adjusted with the largest remainder method so that they always
+// total exactly 100.
+// Returns an error if values is empty, if the values sum to zero, or if the rounding mode is invalid.
+func PercentBreakdown(values []Decimal, places int32, mode rounding.Mode) ([]Decimal, error) {
+	if len(values) == 0 {
+		return nil, errors.ErrEmptyInput
+	}
+
+	total := Zero()
+	for _, v := range values {
+		total = total.Add(v)
+	}
+	if total.IsZero() {
+		return nil, errors.ErrDivideByZero
+	}
+
+	hundred := NewFromInt(100)
+	exact := make([]Decimal, len(values))
+	percents := make([]Decimal, len(values))
+	roundedTotal := Zero()
+	for i, v := range values {
+		var err error
+		exact[i], err = v.Mul(hundred).Div(total)
+		if err != nil {
+			return nil, err
+		}
+		percents[i], err = exact[i].Round(places, mode)
+		if err != nil {
+			return nil, err
+		}
+		roundedTotal = roundedTotal.Add(percents[i])
+	}
+
+	// Distribute the rounding difference so the percentages total exactly 100
+	unit := Decimal{value: decimal.New(1, -places)}
+	steps := hundred.Sub(roundedTotal).value.Div(unit.value).IntPart()
+	distributeRemainder(exact, percents, steps, unit)
+
+	return percents, nil
+}
@@ -0,0 +1,537 @@
+package safedec
+
+import (
+	"errors"
+	"testing"
+
+	finerrors "github.com/nduyhai/finarith/errors"
+	"github.com/nduyhai/finarith/rounding"
+)
+
+func TestDecimal_ClampPercent(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  string
+	}{
+		{
+			name:  "above range",
+			value: "150",
+			want:  "100",
+		},
+		{
+			name:  "below range",
+			value: "-5.5",
+			want:  "0",
+		},
+		{
+			name:  "within range",
+			value: "42.5",
+			want:  "42.5",
+		},
+		{
+			name:  "upper bound",
+			value: "100",
+			want:  "100",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, _ := NewFromString(tt.value)
+			if got := d.ClampPercent(); got.String() != tt.want {
+				t.Errorf("ClampPercent() = %v, want %v", got.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimal_Percentage(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     string
+		percent   string
+		want      string
+		wantErr   bool
+		errorType error
+	}{
+		{
+			name:    "fee on transaction",
+			value:   "20.00",
+			percent: "7.5",
+			want:    "1.5",
+		},
+		{
+			name:    "not rounded",
+			value:   "19.99",
+			percent: "7.5",
+			want:    "1.49925",
+		},
+		{
+			name:    "zero percent",
+			value:   "19.99",
+			percent: "0",
+			want:    "0",
+		},
+		{
+			name:    "one hundred percent",
+			value:   "19.99",
+			percent: "100",
+			want:    "19.99",
+		},
+		{
+			name:      "negative percent",
+			value:     "19.99",
+			percent:   "-1",
+			wantErr:   true,
+			errorType: finerrors.ErrNegativeValue,
+		},
+		{
+			name:      "above one hundred percent",
+			value:     "19.99",
+			percent:   "100.01",
+			wantErr:   true,
+			errorType: finerrors.ErrExceedsLimit,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, _ := NewFromString(tt.value)
+			percent, _ := NewFromString(tt.percent)
+
+			got, err := d.Percentage(percent)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Percentage() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				if !errors.Is(err, tt.errorType) {
+					t.Errorf("Percentage() error type = %v, want %v", err, tt.errorType)
+				}
+				return
+			}
+			if got.String() != tt.want {
+				t.Errorf("Percentage() = %v, want %v", got.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimal_PercentageUnchecked(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		percent string
+		want    string
+	}{
+		{
+			name:    "within range",
+			value:   "80",
+			percent: "12.5",
+			want:    "10",
+		},
+		{
+			name:    "markup above one hundred percent",
+			value:   "80",
+			percent: "150",
+			want:    "120",
+		},
+		{
+			name:    "negative percent",
+			value:   "80",
+			percent: "-10",
+			want:    "-8",
+		},
+		{
+			name:    "exact beyond division precision",
+			value:   "0.03",
+			percent: "33.333333333333333333",
+			want:    "0.0099999999999999999999",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, _ := NewFromString(tt.value)
+			percent, _ := NewFromString(tt.percent)
+			if got := d.PercentageUnchecked(percent); got.String() != tt.want {
+				t.Errorf("PercentageUnchecked() = %v, want %v", got.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimal_PercentageOf(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		total   string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:  "share of revenue",
+			value: "342",
+			total: "1000",
+			want:  "34.2",
+		},
+		{
+			name:  "whole",
+			value: "19.99",
+			total: "19.99",
+			want:  "100",
+		},
+		{
+			name:  "more than the total",
+			value: "30",
+			total: "20",
+			want:  "150",
+		},
+		{
+			name:  "not rounded",
+			value: "1",
+			total: "8",
+			want:  "12.5",
+		},
+		{
+			name:    "zero total",
+			value:   "342",
+			total:   "0",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, _ := NewFromString(tt.value)
+			total, _ := NewFromString(tt.total)
+
+			got, err := d.PercentageOf(total)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PercentageOf() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				if !errors.Is(err, finerrors.ErrDivideByZero) {
+					t.Errorf("PercentageOf() error type = %v, want %v", err, finerrors.ErrDivideByZero)
+				}
+				return
+			}
+			if got.String() != tt.want {
+				t.Errorf("PercentageOf() = %v, want %v", got.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimal_PercentageOfInvertsPercentage(t *testing.T) {
+	total, _ := NewFromString("480.00")
+	percent, _ := NewFromString("7.5")
+
+	amount, err := total.Percentage(percent)
+	if err != nil {
+		t.Fatalf("Percentage() error = %v", err)
+	}
+	got, err := amount.PercentageOf(total)
+	if err != nil {
+		t.Fatalf("PercentageOf() error = %v", err)
+	}
+	if !got.Equal(percent) {
+		t.Errorf("PercentageOf() = %v, want %v", got, percent)
+	}
+}
+
+func TestDecimal_PercentOf(t *testing.T) {
+	tests := []struct {
+		name    string
+		percent string
+		whole   string
+		want    string
+	}{
+		{
+			name:    "fifteen percent of 200",
+			percent: "15",
+			whole:   "200",
+			want:    "30",
+		},
+		{
+			name:    "fractional percent",
+			percent: "7.25",
+			whole:   "19.99",
+			want:    "1.449275",
+		},
+		{
+			name:    "beyond division precision",
+			percent: "33.333333333333333333",
+			whole:   "0.03",
+			want:    "0.0099999999999999999999",
+		},
+		{
+			name:    "negative whole",
+			percent: "10",
+			whole:   "-50",
+			want:    "-5",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			percent, _ := NewFromString(tt.percent)
+			whole, _ := NewFromString(tt.whole)
+
+			got, err := percent.PercentOf(whole)
+			if err != nil {
+				t.Errorf("PercentOf() error = %v", err)
+				return
+			}
+			if got.String() != tt.want {
+				t.Errorf("PercentOf() = %v, want %v", got.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimal_PercentOfRound(t *testing.T) {
+	tests := []struct {
+		name    string
+		percent string
+		whole   string
+		places  int32
+		mode    rounding.Mode
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "half up",
+			percent: "7.25",
+			whole:   "19.99",
+			places:  2,
+			mode:    rounding.RoundHalfUp,
+			want:    "1.45",
+		},
+		{
+			name:    "round down",
+			percent: "7.25",
+			whole:   "19.99",
+			places:  2,
+			mode:    rounding.RoundDown,
+			want:    "1.44",
+		},
+		{
+			name:    "invalid rounding mode",
+			percent: "7.25",
+			whole:   "19.99",
+			places:  2,
+			mode:    rounding.Mode(99),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			percent, _ := NewFromString(tt.percent)
+			whole, _ := NewFromString(tt.whole)
+
+			got, err := percent.PercentOfRound(whole, tt.places, tt.mode)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PercentOfRound() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				if !errors.Is(err, finerrors.ErrInvalidRounding) {
+					t.Errorf("PercentOfRound() error type = %v, want %v", err, finerrors.ErrInvalidRounding)
+				}
+				return
+			}
+			if got.String() != tt.want {
+				t.Errorf("PercentOfRound() = %v, want %v", got.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestPercentage(t *testing.T) {
+	tests := []struct {
+		name       string
+		percent    string
+		amount     string
+		wantOf     string
+		wantString string
+	}{
+		{
+			name:       "whole percentage",
+			percent:    "15",
+			amount:     "200",
+			wantOf:     "30",
+			wantString: "15%",
+		},
+		{
+			name:       "fractional percentage",
+			percent:    "2.90",
+			amount:     "100.00",
+			wantOf:     "2.9",
+			wantString: "2.9%",
+		},
+		{
+			name:       "above one hundred",
+			percent:    "150",
+			amount:     "80",
+			wantOf:     "120",
+			wantString: "150%",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, _ := NewFromString(tt.percent)
+			amount, _ := NewFromString(tt.amount)
+			p := NewPercentage(value)
+
+			if got := p.Of(amount); got.String() != tt.wantOf {
+				t.Errorf("Percentage.Of() = %v, want %v", got.String(), tt.wantOf)
+			}
+			if got := p.String(); got != tt.wantString {
+				t.Errorf("Percentage.String() = %v, want %v", got, tt.wantString)
+			}
+			if !p.Decimal().Equal(value) {
+				t.Errorf("Percentage.Decimal() = %v, want %v", p.Decimal(), value)
+			}
+		})
+	}
+}
+
+func TestDecimal_AsFraction(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  string
+	}{
+		{
+			name:  "whole percentage",
+			value: "25",
+			want:  "0.25",
+		},
+		{
+			name:  "fractional percentage",
+			value: "2.9",
+			want:  "0.029",
+		},
+		{
+			name:  "hundred percent",
+			value: "100",
+			want:  "1",
+		},
+		{
+			name:  "zero",
+			value: "0",
+			want:  "0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, _ := NewFromString(tt.value)
+			if got := d.AsFraction(); got.String() != tt.want {
+				t.Errorf("AsFraction() = %v, want %v", got.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestPercentBreakdown(t *testing.T) {
+	tests := []struct {
+		name      string
+		values    []string
+		places    int32
+		mode      rounding.Mode
+		want      []string
+		wantErr   bool
+		errorType error
+	}{
+		{
+			name:   "naive rounding totals 99.99",
+			values: []string{"1", "1", "1"},
+			places: 2,
+			mode:   rounding.RoundHalfUp,
+			want:   []string{"33.34", "33.33", "33.33"},
+		},
+		{
+			name:   "naive rounding totals 100.02",
+			values: []string{"1", "1", "1"},
+			places: 2,
+			mode:   rounding.RoundUp,
+			want:   []string{"33.33", "33.33", "33.34"},
+		},
+		{
+			name:   "largest remainder receives the adjustment",
+			values: []string{"10", "20", "40"},
+			places: 1,
+			mode:   rounding.RoundDown,
+			want:   []string{"14.3", "28.6", "57.1"},
+		},
+		{
+			name:   "exact percentages",
+			values: []string{"25", "75"},
+			places: 2,
+			mode:   rounding.RoundHalfUp,
+			want:   []string{"25", "75"},
+		},
+		{
+			name:      "empty input",
+			values:    []string{},
+			places:    2,
+			mode:      rounding.RoundHalfUp,
+			wantErr:   true,
+			errorType: finerrors.ErrEmptyInput,
+		},
+		{
+			name:      "zero sum",
+			values:    []string{"0", "0"},
+			places:    2,
+			mode:      rounding.RoundHalfUp,
+			wantErr:   true,
+			errorType: finerrors.ErrDivideByZero,
+		},
+		{
+			name:      "invalid rounding mode",
+			values:    []string{"1", "2"},
+			places:    2,
+			mode:      rounding.Mode(99),
+			wantErr:   true,
+			errorType: finerrors.ErrInvalidRounding,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values := make([]Decimal, len(tt.values))
+			for i, v := range tt.values {
+				values[i], _ = NewFromString(v)
+			}
+
+			got, err := PercentBreakdown(values, tt.places, tt.mode)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PercentBreakdown() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				if !errors.Is(err, tt.errorType) {
+					t.Errorf("PercentBreakdown() error type = %v, want %v", err, tt.errorType)
+				}
+				return
+			}
+
+			total := Zero()
+			for i, want := range tt.want {
+				if got[i].String() != want {
+					t.Errorf("PercentBreakdown()[%d] = %v, want %v", i, got[i].String(), want)
+				}
+				total = total.Add(got[i])
+			}
+			if !total.Equal(NewFromInt(100)) {
+				t.Errorf("PercentBreakdown() total = %v, want 100", total.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,166 @@
+package safedec
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/nduyhai/finarith/errors"
+	"github.com/nduyhai/finarith/rounding"
+)
+
+// ratePrecision is the number of decimal places kept in intermediate rate calculations.
+const ratePrecision = 16
+
+// Day count bases for AccrueBetween, given as the number of days in the year.
+const (
+	// DayCountACT365 divides the actual number of days by 365.
+	DayCountACT365 = 365
+
+	// DayCountACT360 divides the actual number of days by 360.
+	DayCountACT360 = 360
+)
+
+// NominalToEffective converts a nominal annual rate compounded compoundsPerYear times a year
+// into the effective annual rate, computed as (1 + nominal/m)^m - 1 and rounded to the specified
+// number of decimal places using the specified rounding mode. Rates are fractions (0.12 for 12%).
+// Returns an error if compoundsPerYear is not positive or if the rounding mode is invalid.
+func NominalToEffective(nominalRate Decimal, compoundsPerYear int, places int32, mode rounding.Mode) (Decimal, error) {
+	if compoundsPerYear <= 0 {
+		return Decimal{}, errors.ErrInvalidArgument
+	}
+
+	m := decimal.NewFromInt(int64(compoundsPerYear))
+	periodic := decimal.NewFromInt(1).Add(nominalRate.value.DivRound(m, ratePrecision))
+	growth, err := periodic.PowWithPrecision(m, ratePrecision)
+	if err != nil {
+		return Decimal{}, err
+	}
+
+	return Decimal{value: growth.Sub(decimal.NewFromInt(1))}.Round(places, mode)
+}
+
+// EffectiveToNominal converts an effective annual rate into the nominal annual rate compounded
+// compoundsPerYear times a year, computed as m * ((1 + effective)^(1/m) - 1) and rounded to the
+// specified number of decimal places using the specified rounding mode. Rates are fractions.
+// Returns an error if compoundsPerYear is not positive, if the effective rate is -100% or less,
+// or if the rounding mode is invalid.
+func EffectiveToNominal(effectiveRate Decimal, compoundsPerYear int, places int32, mode rounding.Mode) (Decimal, error) {
+	if compoundsPerYear <= 0 {
+		return Decimal{}, errors.ErrInvalidArgument
+	}
+
+	growth := decimal.NewFromInt(1).Add(effectiveRate.value)
+	if !growth.IsPositive() {
+		return Decimal{}, errors.ErrInvalidArgument
+	}
+
+	m := decimal.NewFromInt(int64(compoundsPerYear))
+	periodic, err := growth.PowWithPrecision(decimal.NewFromInt(1).DivRound(m, ratePrecision), ratePrecision)
+	if err != nil {
+		return Decimal{}, err
+	}
+
+	return Decimal{value: periodic.Sub(decimal.NewFromInt(1)).Mul(m)}.Round(places, mode)
+}
+
+// PaymentAmount calculates the level payment that repays the principal over the given number of
+// years with paymentsPerYear payments a year, where interest at the nominal annualRate compounds
+// at the payment frequency. It is PaymentAmountCompounded with compoundsPerYear equal to
+// paymentsPerYear. Rates are fractions (0.06 for 6%).
+// Returns an error if paymentsPerYear or years is not positive or if the rounding mode is invalid.
+func PaymentAmount(principal, annualRate Decimal, paymentsPerYear, years int, mode rounding.Mode, places int32) (Decimal, error) {
+	return PaymentAmountCompounded(principal, annualRate, paymentsPerYear, paymentsPerYear, years, mode, places)
+}
+
+// PaymentAmountCompounded calculates the level payment that repays the principal over the given
+// number of years with paymentsPerYear payments a year, where interest at the nominal annualRate
+// compounds compoundsPerYear times a year (e.g. semi-annual compounding with monthly payments).
+// It uses P * r / (1 - (1 + r)^-n) with n = paymentsPerYear*years and the periodic rate
+// r = (1 + annualRate/compoundsPerYear)^(compoundsPerYear/paymentsPerYear) - 1, which is
+// annualRate/paymentsPerYear when the frequencies match. A periodic rate that is zero at 16
+// decimal places, including a zero annual rate, gives P / n. The payment is rounded to the
+// specified number of decimal places using the specified rounding mode. Rates are fractions.
+// Returns an error if paymentsPerYear, compoundsPerYear or years is not positive, if the rate per
+// compounding period is -100% or less, or if the rounding mode is invalid.
+func PaymentAmountCompounded(principal, annualRate Decimal, paymentsPerYear, compoundsPerYear, years int, mode rounding.Mode, places int32) (Decimal, error) {
+	if paymentsPerYear <= 0 || compoundsPerYear <= 0 || years <= 0 {
+		return Decimal{}, errors.ErrInvalidArgument
+	}
+
+	n := decimal.NewFromInt(int64(paymentsPerYear) * int64(years))
+	one := decimal.NewFromInt(1)
+
+	// One plus the rate per compounding period must be positive in either branch
+	periodRate := annualRate.value.DivRound(decimal.NewFromInt(int64(compoundsPerYear)), ratePrecision)
+	base := one.Add(periodRate)
+	if !base.IsPositive() {
+		return Decimal{}, errors.ErrInvalidArgument
+	}
+
+	r := periodRate
+	if compoundsPerYear != paymentsPerYear {
+		exponent := decimal.NewFromInt(int64(compoundsPerYear)).DivRound(decimal.NewFromInt(int64(paymentsPerYear)), ratePrecision)
+		growth, err := base.PowWithPrecision(exponent, ratePrecision)
+		if err != nil {
+			return Decimal{}, err
+		}
+		r = growth.Sub(one)
+	}
+
+	growth, err := one.Add(r).PowWithPrecision(n, ratePrecision)
+	if err != nil {
+		return Decimal{}, err
+	}
+
+	// A rate too small to survive the intermediate precision leaves nothing to compound
+	if r.IsZero() || growth.Equal(one) {
+		return Decimal{value: principal.value.DivRound(n, ratePrecision)}.Round(places, mode)
+	}
+
+	// P * r / (1 - (1 + r)^-n) is rewritten as P * r * g / (g - 1) with g = (1 + r)^n
+	payment := principal.value.Mul(r).Mul(growth).DivRound(growth.Sub(one), ratePrecision)
+	return Decimal{value: payment}.Round(places, mode)
+}
+
+// AccrueBetween calculates the simple interest accrued on principal at annualRate between two
+// timestamps, computed as principal * annualRate * days / basis and rounded to the specified number
+// of decimal places using the specified rounding mode. Days are the actual number of calendar days
+// between the UTC dates of from and to, so the time of day does not matter and spans too long for
+// a time.Duration are still counted exactly. The basis is DayCountACT365 or DayCountACT360, and
+// rates are fractions (0.05 for 5%).
+// Returns an error if to is before from, if the basis is unknown, or if the rounding mode is invalid.
+func AccrueBetween(principal, annualRate Decimal, from, to time.Time, basis int, mode rounding.Mode, places int32) (Decimal, error) {
+	if basis != DayCountACT365 && basis != DayCountACT360 {
+		return Decimal{}, fmt.Errorf("%w: unknown day count basis %d", errors.ErrInvalidArgument, basis)
+	}
+
+	days := dayNumber(to) - dayNumber(from)
+	if days < 0 {
+		return Decimal{}, fmt.Errorf("%w: accrual end %v is before start %v", errors.ErrInvalidArgument, to, from)
+	}
+
+	interest := principal.value.Mul(annualRate.value).Mul(decimal.NewFromInt(days)).DivRound(decimal.NewFromInt(int64(basis)), ratePrecision)
+	return Decimal{value: interest}.Round(places, mode)
+}
+
+// DailyRate spreads a monthly amount evenly over the actual number of days in the given month,
+// rounded to the specified number of decimal places using the specified rounding mode, so a
+// February day costs more than a January day and a leap-year February less than a common one.
+// Returns an error if the month is not between January and December or if the rounding mode is invalid.
+func DailyRate(monthlyAmount Decimal, year int, month time.Month, mode rounding.Mode, places int32) (Decimal, error) {
+	if month < time.January || month > time.December {
+		return Decimal{}, fmt.Errorf("%w: invalid month %d", errors.ErrInvalidArgument, month)
+	}
+
+	// Day zero of the following month normalizes to the last day of this one
+	days := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	return monthlyAmount.DivRound(NewFromInt(int64(days)), places, mode)
+}
+
+// dayNumber returns the number of days between the Unix epoch and the UTC date of t.
+func dayNumber(t time.Time) int64 {
+	y, m, d := t.UTC().Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC).Unix() / 86400
+}
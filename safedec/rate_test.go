@@ -0,0 +1,558 @@
+package safedec
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	finerrors "github.com/nduyhai/finarith/errors"
+	"github.com/nduyhai/finarith/rounding"
+)
+
+func TestNominalToEffective(t *testing.T) {
+	tests := []struct {
+		name             string
+		nominalRate      string
+		compoundsPerYear int
+		places           int32
+		want             string
+		wantErr          bool
+		errorType        error
+	}{
+		{
+			name:             "12% compounded monthly",
+			nominalRate:      "0.12",
+			compoundsPerYear: 12,
+			places:           6,
+			want:             "0.126825",
+		},
+		{
+			name:             "5% compounded daily",
+			nominalRate:      "0.05",
+			compoundsPerYear: 365,
+			places:           6,
+			want:             "0.051267",
+		},
+		{
+			name:             "compounded annually",
+			nominalRate:      "0.07",
+			compoundsPerYear: 1,
+			places:           6,
+			want:             "0.07",
+		},
+		{
+			name:             "zero compounds per year",
+			nominalRate:      "0.12",
+			compoundsPerYear: 0,
+			places:           6,
+			wantErr:          true,
+			errorType:        finerrors.ErrInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nominalRate, _ := NewFromString(tt.nominalRate)
+			got, err := NominalToEffective(nominalRate, tt.compoundsPerYear, tt.places, rounding.RoundHalfUp)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NominalToEffective() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil && !errors.Is(err, tt.errorType) {
+				t.Errorf("NominalToEffective() error type = %v, want %v", err, tt.errorType)
+			}
+			if !tt.wantErr && got.String() != tt.want {
+				t.Errorf("NominalToEffective() = %v, want %v", got.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestEffectiveToNominal(t *testing.T) {
+	tests := []struct {
+		name             string
+		effectiveRate    string
+		compoundsPerYear int
+		places           int32
+		want             string
+		wantErr          bool
+		errorType        error
+	}{
+		{
+			name:             "12% compounded monthly",
+			effectiveRate:    "0.126825030131969720661201",
+			compoundsPerYear: 12,
+			places:           6,
+			want:             "0.12",
+		},
+		{
+			name:             "rounded effective rate",
+			effectiveRate:    "0.126825",
+			compoundsPerYear: 12,
+			places:           6,
+			want:             "0.12",
+		},
+		{
+			name:             "compounded annually",
+			effectiveRate:    "0.07",
+			compoundsPerYear: 1,
+			places:           6,
+			want:             "0.07",
+		},
+		{
+			name:             "zero compounds per year",
+			effectiveRate:    "0.12",
+			compoundsPerYear: 0,
+			places:           6,
+			wantErr:          true,
+			errorType:        finerrors.ErrInvalidArgument,
+		},
+		{
+			name:             "total loss",
+			effectiveRate:    "-1",
+			compoundsPerYear: 12,
+			places:           6,
+			wantErr:          true,
+			errorType:        finerrors.ErrInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			effectiveRate, _ := NewFromString(tt.effectiveRate)
+			got, err := EffectiveToNominal(effectiveRate, tt.compoundsPerYear, tt.places, rounding.RoundHalfUp)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("EffectiveToNominal() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil && !errors.Is(err, tt.errorType) {
+				t.Errorf("EffectiveToNominal() error type = %v, want %v", err, tt.errorType)
+			}
+			if !tt.wantErr && got.String() != tt.want {
+				t.Errorf("EffectiveToNominal() = %v, want %v", got.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestPaymentAmount(t *testing.T) {
+	tests := []struct {
+		name            string
+		principal       string
+		annualRate      string
+		paymentsPerYear int
+		years           int
+		want            string
+		wantErr         bool
+	}{
+		{
+			name:            "30 year monthly mortgage",
+			principal:       "200000",
+			annualRate:      "0.06",
+			paymentsPerYear: 12,
+			years:           30,
+			want:            "1199.1",
+		},
+		{
+			name:            "5 year monthly car loan",
+			principal:       "25000",
+			annualRate:      "0.045",
+			paymentsPerYear: 12,
+			years:           5,
+			want:            "466.08",
+		},
+		{
+			name:            "quarterly payments",
+			principal:       "10000",
+			annualRate:      "0.08",
+			paymentsPerYear: 4,
+			years:           2,
+			want:            "1365.1",
+		},
+		{
+			name:            "zero interest loan",
+			principal:       "10000",
+			annualRate:      "0",
+			paymentsPerYear: 12,
+			years:           1,
+			want:            "833.33",
+		},
+		{
+			name:            "rate that vanishes at the intermediate precision",
+			principal:       "10000",
+			annualRate:      "0.00000000000000001",
+			paymentsPerYear: 12,
+			years:           1,
+			want:            "833.33",
+		},
+		{
+			name:            "rate of -100% per period",
+			principal:       "10000",
+			annualRate:      "-12",
+			paymentsPerYear: 12,
+			years:           1,
+			wantErr:         true,
+		},
+		{
+			name:            "rate below -100% per period",
+			principal:       "10000",
+			annualRate:      "-24",
+			paymentsPerYear: 12,
+			years:           1,
+			wantErr:         true,
+		},
+		{
+			name:            "rate far below -100% per period",
+			principal:       "10000",
+			annualRate:      "-36",
+			paymentsPerYear: 12,
+			years:           1,
+			wantErr:         true,
+		},
+		{
+			name:            "zero payments per year",
+			principal:       "10000",
+			annualRate:      "0.05",
+			paymentsPerYear: 0,
+			years:           1,
+			wantErr:         true,
+		},
+		{
+			name:            "zero years",
+			principal:       "10000",
+			annualRate:      "0.05",
+			paymentsPerYear: 12,
+			years:           0,
+			wantErr:         true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			principal, _ := NewFromString(tt.principal)
+			annualRate, _ := NewFromString(tt.annualRate)
+
+			got, err := PaymentAmount(principal, annualRate, tt.paymentsPerYear, tt.years, rounding.RoundHalfUp, 2)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PaymentAmount() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				if !errors.Is(err, finerrors.ErrInvalidArgument) {
+					t.Errorf("PaymentAmount() error type = %v, want %v", err, finerrors.ErrInvalidArgument)
+				}
+				return
+			}
+			if got.String() != tt.want {
+				t.Errorf("PaymentAmount() = %v, want %v", got.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestPaymentAmountCompounded(t *testing.T) {
+	tests := []struct {
+		name             string
+		principal        string
+		annualRate       string
+		paymentsPerYear  int
+		compoundsPerYear int
+		years            int
+		want             string
+		wantErr          bool
+	}{
+		{
+			name:             "semi-annual compounding with monthly payments",
+			principal:        "100000",
+			annualRate:       "0.06",
+			paymentsPerYear:  12,
+			compoundsPerYear: 2,
+			years:            25,
+			want:             "639.81",
+		},
+		{
+			name:             "daily compounding with monthly payments",
+			principal:        "10000",
+			annualRate:       "0.05",
+			paymentsPerYear:  12,
+			compoundsPerYear: 365,
+			years:            3,
+			want:             "299.75",
+		},
+		{
+			name:             "monthly compounding with quarterly payments",
+			principal:        "10000",
+			annualRate:       "0.08",
+			paymentsPerYear:  4,
+			compoundsPerYear: 12,
+			years:            2,
+			want:             "1365.88",
+		},
+		{
+			name:             "matching frequencies equal PaymentAmount",
+			principal:        "200000",
+			annualRate:       "0.06",
+			paymentsPerYear:  12,
+			compoundsPerYear: 12,
+			years:            30,
+			want:             "1199.1",
+		},
+		{
+			name:             "zero interest loan",
+			principal:        "10000",
+			annualRate:       "0",
+			paymentsPerYear:  12,
+			compoundsPerYear: 2,
+			years:            1,
+			want:             "833.33",
+		},
+		{
+			name:             "rate that vanishes at the intermediate precision",
+			principal:        "10000",
+			annualRate:       "0.00000000000000001",
+			paymentsPerYear:  12,
+			compoundsPerYear: 2,
+			years:            1,
+			want:             "833.33",
+		},
+		{
+			name:             "zero compounds per year",
+			principal:        "10000",
+			annualRate:       "0.05",
+			paymentsPerYear:  12,
+			compoundsPerYear: 0,
+			years:            1,
+			wantErr:          true,
+		},
+		{
+			name:             "rate of -100% per compounding period",
+			principal:        "10000",
+			annualRate:       "-4",
+			paymentsPerYear:  12,
+			compoundsPerYear: 4,
+			years:            1,
+			wantErr:          true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			principal, _ := NewFromString(tt.principal)
+			annualRate, _ := NewFromString(tt.annualRate)
+
+			got, err := PaymentAmountCompounded(principal, annualRate, tt.paymentsPerYear, tt.compoundsPerYear, tt.years, rounding.RoundHalfUp, 2)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PaymentAmountCompounded() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				if !errors.Is(err, finerrors.ErrInvalidArgument) {
+					t.Errorf("PaymentAmountCompounded() error type = %v, want %v", err, finerrors.ErrInvalidArgument)
+				}
+				return
+			}
+			if got.String() != tt.want {
+				t.Errorf("PaymentAmountCompounded() = %v, want %v", got.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestAccrueBetween(t *testing.T) {
+	from := time.Date(2024, time.January, 1, 9, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		principal string
+		rate      string
+		from      time.Time
+		to        time.Time
+		basis     int
+		want      string
+		wantErr   bool
+	}{
+		{
+			name:      "30 days ACT/365",
+			principal: "10000",
+			rate:      "0.05",
+			from:      from,
+			to:        from.AddDate(0, 0, 30),
+			basis:     DayCountACT365,
+			want:      "41.1",
+		},
+		{
+			name:      "30 days ACT/360",
+			principal: "10000",
+			rate:      "0.05",
+			from:      from,
+			to:        from.AddDate(0, 0, 30),
+			basis:     DayCountACT360,
+			want:      "41.67",
+		},
+		{
+			name:      "time of day ignored",
+			principal: "10000",
+			rate:      "0.05",
+			from:      from,
+			to:        time.Date(2024, time.January, 31, 0, 5, 0, 0, time.UTC),
+			basis:     DayCountACT365,
+			want:      "41.1",
+		},
+		{
+			name:      "same day",
+			principal: "10000",
+			rate:      "0.05",
+			from:      from,
+			to:        from,
+			basis:     DayCountACT360,
+			want:      "0",
+		},
+		{
+			name:      "leap year counts actual days",
+			principal: "36500",
+			rate:      "0.01",
+			from:      from,
+			to:        from.AddDate(1, 0, 0),
+			basis:     DayCountACT365,
+			want:      "366",
+		},
+		{
+			name:      "span longer than a time.Duration",
+			principal: "1000",
+			rate:      "0.01",
+			from:      time.Date(1700, time.January, 1, 0, 0, 0, 0, time.UTC),
+			to:        time.Date(2100, time.January, 1, 0, 0, 0, 0, time.UTC),
+			basis:     DayCountACT360,
+			want:      "4058.25",
+		},
+		{
+			name:      "end before start",
+			principal: "10000",
+			rate:      "0.05",
+			from:      from,
+			to:        from.AddDate(0, 0, -1),
+			basis:     DayCountACT365,
+			wantErr:   true,
+		},
+		{
+			name:      "unknown basis",
+			principal: "10000",
+			rate:      "0.05",
+			from:      from,
+			to:        from.AddDate(0, 0, 30),
+			basis:     364,
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			principal, _ := NewFromString(tt.principal)
+			rate, _ := NewFromString(tt.rate)
+
+			got, err := AccrueBetween(principal, rate, tt.from, tt.to, tt.basis, rounding.RoundHalfUp, 2)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("AccrueBetween() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				if !errors.Is(err, finerrors.ErrInvalidArgument) {
+					t.Errorf("AccrueBetween() error is not ErrInvalidArgument: %v", err)
+				}
+				return
+			}
+			if got.String() != tt.want {
+				t.Errorf("AccrueBetween() = %v, want %v", got.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestDailyRate(t *testing.T) {
+	tests := []struct {
+		name    string
+		amount  string
+		year    int
+		month   time.Month
+		want    string
+		wantErr bool
+	}{
+		{
+			name:   "31-day month",
+			amount: "100",
+			year:   2023,
+			month:  time.January,
+			want:   "3.23",
+		},
+		{
+			name:   "30-day month",
+			amount: "100",
+			year:   2023,
+			month:  time.April,
+			want:   "3.33",
+		},
+		{
+			name:   "February in a common year",
+			amount: "100",
+			year:   2023,
+			month:  time.February,
+			want:   "3.57",
+		},
+		{
+			name:   "February in a leap year",
+			amount: "100",
+			year:   2024,
+			month:  time.February,
+			want:   "3.45",
+		},
+		{
+			name:   "February in a century common year",
+			amount: "100",
+			year:   1900,
+			month:  time.February,
+			want:   "3.57",
+		},
+		{
+			name:   "December",
+			amount: "62",
+			year:   2023,
+			month:  time.December,
+			want:   "2",
+		},
+		{
+			name:    "month zero",
+			amount:  "100",
+			year:    2023,
+			month:   0,
+			wantErr: true,
+		},
+		{
+			name:    "month thirteen",
+			amount:  "100",
+			year:    2023,
+			month:   13,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			amount, _ := NewFromString(tt.amount)
+
+			got, err := DailyRate(amount, tt.year, tt.month, rounding.RoundHalfUp, 2)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("DailyRate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				if !errors.Is(err, finerrors.ErrInvalidArgument) {
+					t.Errorf("DailyRate() error is not ErrInvalidArgument: %v", err)
+				}
+				return
+			}
+			if got.String() != tt.want {
+				t.Errorf("DailyRate() = %v, want %v", got.String(), tt.want)
+			}
+		})
+	}
+}
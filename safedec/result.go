@@ -0,0 +1,12 @@
+package safedec
+
+import (
+	"github.com/nduyhai/finarith/result"
+)
+
+// ResultFromString creates a Decimal from a string representation using the default parser and
+// returns it as a result.Result, so that parsing can be chained with further steps using Then.
+// The Result holds the same error as NewFromString if the string is not a valid decimal.
+func ResultFromString(value string) result.Result[Decimal] {
+	return result.New(NewFromString(value))
+}
@@ -0,0 +1,80 @@
+package safedec
+
+import (
+	"errors"
+	"testing"
+
+	finerrors "github.com/nduyhai/finarith/errors"
+	"github.com/nduyhai/finarith/rounding"
+)
+
+func TestResultFromString(t *testing.T) {
+	roundCents := func(d Decimal) (Decimal, error) {
+		return d.Round(2, rounding.RoundHalfEven)
+	}
+	nonNegative := func(d Decimal) (Decimal, error) {
+		if d.IsNegative() {
+			return Decimal{}, finerrors.ErrNegativeValue
+		}
+		return d, nil
+	}
+
+	tests := []struct {
+		name      string
+		value     string
+		want      string
+		wantErr   bool
+		errorType error
+	}{
+		{
+			name:  "parse and round",
+			value: "10.125",
+			want:  "10.12",
+		},
+		{
+			name:  "already rounded",
+			value: "3.50",
+			want:  "3.5",
+		},
+		{
+			name:    "invalid input stops the chain",
+			value:   "1O.00",
+			wantErr: true,
+		},
+		{
+			name:      "failing step stops the chain",
+			value:     "-10.125",
+			wantErr:   true,
+			errorType: finerrors.ErrNegativeValue,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ResultFromString(tt.value).Then(nonNegative).Then(roundCents).Get()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ResultFromString() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				if tt.errorType != nil && !errors.Is(err, tt.errorType) {
+					t.Errorf("ResultFromString() error = %v, want error type %v", err, tt.errorType)
+				}
+				return
+			}
+			if got.String() != tt.want {
+				t.Errorf("ResultFromString() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResultFromString_Or(t *testing.T) {
+	def := NewFromInt(-1)
+	if got := ResultFromString("not a number").Or(def); !got.Equal(def) {
+		t.Errorf("Or() = %v, want %v", got, def)
+	}
+	if got := ResultFromString("2.5").Or(def); got.String() != "2.5" {
+		t.Errorf("Or() = %v, want 2.5", got)
+	}
+}
@@ -0,0 +1,148 @@
+package safedec
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/nduyhai/finarith/errors"
+	"github.com/nduyhai/finarith/rounding"
+)
+
+// RoundToSumTo rounds each value to the specified number of decimal places using the specified
+// rounding mode, then adjusts the rounded values with the largest remainder method so that they
+// sum exactly to targetTotal rounded the same way.
+// Returns an error if values is empty or if the rounding mode is invalid. If the values and the
+// target differ by more than one unit per value at that scale, they are at different scales (e.g.
+// dollars against a total in cents) and the error matches both ErrScaleMismatch and ErrExceedsLimit.
+func RoundToSumTo(values []Decimal, targetTotal Decimal, places int32, mode rounding.Mode) ([]Decimal, error) {
+	if len(values) == 0 {
+		return nil, errors.ErrEmptyInput
+	}
+
+	target, err := targetTotal.Round(places, mode)
+	if err != nil {
+		return nil, err
+	}
+
+	rounded := make([]Decimal, len(values))
+	roundedTotal := Zero()
+	for i, v := range values {
+		rounded[i], err = v.Round(places, mode)
+		if err != nil {
+			return nil, err
+		}
+		roundedTotal = roundedTotal.Add(rounded[i])
+	}
+
+	unit := Decimal{value: decimal.New(1, -places)}
+	steps := target.Sub(roundedTotal).value.Div(unit.value).IntPart()
+	if steps > int64(len(values)) || -steps > int64(len(values)) {
+		maxAdjustment := unit.Mul(NewFromInt(int64(len(values))))
+		limitErr := errors.NewLimitError(target.Sub(roundedTotal).Abs().String(), maxAdjustment.String(), "rounding adjustment")
+		return nil, fmt.Errorf("%w: %w", errors.ErrScaleMismatch, limitErr)
+	}
+
+	distributeRemainder(values, rounded, steps, unit)
+	return rounded, nil
+}
+
+// RoundInstallments rounds each loan payment to the specified number of decimal places using the
+// specified rounding mode, adjusting the rounded payments with the largest remainder method so
+// that they sum exactly to expectedTotal (typically principal plus total interest).
+// Returns an error if payments is empty, if expectedTotal cannot be represented at the given
+// number of decimal places, if the rounding mode is invalid, or if the payments do not tie out
+// to the expected total.
+func RoundInstallments(payments []Decimal, expectedTotal Decimal, places int32, mode rounding.Mode) ([]Decimal, error) {
+	if !expectedTotal.value.Equal(expectedTotal.value.Truncate(places)) {
+		return nil, errors.ErrInvalidPrecision
+	}
+	return RoundToSumTo(payments, expectedTotal, places, mode)
+}
+
+// RoundToIncrement rounds the value to a multiple of increment (e.g. 0.05 for nickel pricing)
+// using the specified rounding mode, with the mode applied to the number of increments.
+// The rounding is exact for any increment, including ones that do not divide a power of ten.
+// Returns ErrInvalidArgument if the increment is not positive, or ErrInvalidRounding if the
+// rounding mode is invalid.
+func RoundToIncrement(value, increment Decimal, mode rounding.Mode) (Decimal, error) {
+	if !increment.IsPositive() {
+		return Decimal{}, errors.ErrInvalidArgument
+	}
+
+	// Split the value into whole increments, truncated toward zero, and an exact remainder
+	quotient, remainder := value.value.QuoRem(increment.value, 0)
+
+	// Compare twice the remainder with the increment to locate the midpoint
+	half := remainder.Abs().Mul(decimal.NewFromInt(2)).Cmp(increment.value)
+
+	var away bool
+	switch mode {
+	case rounding.RoundDown:
+		away = false
+	case rounding.RoundUp:
+		away = true
+	case rounding.RoundCeiling:
+		away = value.IsPositive()
+	case rounding.RoundFloor:
+		away = value.IsNegative()
+	case rounding.RoundHalfUp:
+		away = half >= 0
+	case rounding.RoundHalfDown:
+		away = half > 0
+	case rounding.RoundHalfEven:
+		away = half > 0 || (half == 0 && !quotient.Mod(decimal.NewFromInt(2)).IsZero())
+	default:
+		return Decimal{}, errors.ErrInvalidRounding
+	}
+
+	if away && !remainder.IsZero() {
+		quotient = quotient.Add(decimal.NewFromInt(int64(value.value.Sign())))
+	}
+	return Decimal{value: quotient.Mul(increment.value)}, nil
+}
+
+// RoundToIncrementWithFloor rounds the value to a multiple of increment using the specified
+// rounding mode, then raises the result to floor if rounding left it below, so that a price
+// rounded for cash is never below cost. The floor itself is returned as is, even if it is not a
+// multiple of increment.
+// Returns the same errors as RoundToIncrement.
+func RoundToIncrementWithFloor(value, increment, floor Decimal, mode rounding.Mode) (Decimal, error) {
+	rounded, err := RoundToIncrement(value, increment, mode)
+	if err != nil {
+		return Decimal{}, err
+	}
+	return MaxValue(rounded, floor), nil
+}
+
+// distributeRemainder adds steps units to the rounded values, one unit per value, starting with
+// the values that lost the most to rounding. A negative steps removes units, starting with the
+// values that gained the most. Steps beyond the number of values wrap around.
+func distributeRemainder(exact, rounded []Decimal, steps int64, unit Decimal) {
+	if steps == 0 {
+		return
+	}
+
+	order := make([]int, len(exact))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		remA := exact[order[a]].Sub(rounded[order[a]])
+		remB := exact[order[b]].Sub(rounded[order[b]])
+		if steps > 0 {
+			return remA.GreaterThan(remB)
+		}
+		return remA.LessThan(remB)
+	})
+
+	if steps < 0 {
+		unit = unit.Neg()
+		steps = -steps
+	}
+	for i := int64(0); i < steps; i++ {
+		idx := order[i%int64(len(order))]
+		rounded[idx] = rounded[idx].Add(unit)
+	}
+}
@@ -0,0 +1,399 @@
+package safedec
+
+import (
+	"errors"
+	"testing"
+
+	finerrors "github.com/nduyhai/finarith/errors"
+	"github.com/nduyhai/finarith/rounding"
+)
+
+func TestRoundToSumTo(t *testing.T) {
+	tests := []struct {
+		name        string
+		values      []string
+		targetTotal string
+		places      int32
+		mode        rounding.Mode
+		want        []string
+		wantErr     bool
+		errorType   error
+	}{
+		{
+			name:        "independent rounding misses by a cent",
+			values:      []string{"33.333", "33.333", "33.334"},
+			targetTotal: "100.00",
+			places:      2,
+			mode:        rounding.RoundHalfUp,
+			want:        []string{"33.33", "33.33", "33.34"},
+		},
+		{
+			name:        "independent rounding overshoots by a cent",
+			values:      []string{"10.125", "20.125", "30.12"},
+			targetTotal: "60.37",
+			places:      2,
+			mode:        rounding.RoundHalfUp,
+			want:        []string{"10.12", "20.13", "30.12"},
+		},
+		{
+			name:        "target total is rounded",
+			values:      []string{"1.004", "2.004"},
+			targetTotal: "3.008",
+			places:      2,
+			mode:        rounding.RoundHalfUp,
+			want:        []string{"1.01", "2"},
+		},
+		{
+			name:        "already ties out",
+			values:      []string{"1.25", "2.50"},
+			targetTotal: "3.75",
+			places:      2,
+			mode:        rounding.RoundHalfUp,
+			want:        []string{"1.25", "2.5"},
+		},
+		{
+			name:        "values do not tie out to target",
+			values:      []string{"1.00", "2.00"},
+			targetTotal: "10.00",
+			places:      2,
+			mode:        rounding.RoundHalfUp,
+			wantErr:     true,
+			errorType:   finerrors.ErrExceedsLimit,
+		},
+		{
+			name:        "target in cents against values in dollars",
+			values:      []string{"1.50", "2.25"},
+			targetTotal: "375",
+			places:      2,
+			mode:        rounding.RoundHalfUp,
+			wantErr:     true,
+			errorType:   finerrors.ErrScaleMismatch,
+		},
+		{
+			name:        "empty input",
+			values:      []string{},
+			targetTotal: "0",
+			places:      2,
+			mode:        rounding.RoundHalfUp,
+			wantErr:     true,
+			errorType:   finerrors.ErrEmptyInput,
+		},
+		{
+			name:        "invalid rounding mode",
+			values:      []string{"1.00"},
+			targetTotal: "1.00",
+			places:      2,
+			mode:        rounding.Mode(99),
+			wantErr:     true,
+			errorType:   finerrors.ErrInvalidRounding,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values := make([]Decimal, len(tt.values))
+			for i, v := range tt.values {
+				values[i], _ = NewFromString(v)
+			}
+			targetTotal, _ := NewFromString(tt.targetTotal)
+
+			got, err := RoundToSumTo(values, targetTotal, tt.places, tt.mode)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("RoundToSumTo() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				if !errors.Is(err, tt.errorType) {
+					t.Errorf("RoundToSumTo() error type = %v, want %v", err, tt.errorType)
+				}
+				return
+			}
+
+			total := Zero()
+			for i, want := range tt.want {
+				if got[i].String() != want {
+					t.Errorf("RoundToSumTo()[%d] = %v, want %v", i, got[i].String(), want)
+				}
+				total = total.Add(got[i])
+			}
+
+			wantTotal, _ := targetTotal.Round(tt.places, tt.mode)
+			if !total.Equal(wantTotal) {
+				t.Errorf("RoundToSumTo() total = %v, want %v", total.String(), wantTotal.String())
+			}
+		})
+	}
+}
+
+func TestRoundInstallments(t *testing.T) {
+	tests := []struct {
+		name          string
+		payments      []string
+		expectedTotal string
+		places        int32
+		want          []string
+		wantErr       bool
+		errorType     error
+	}{
+		{
+			name:          "independent rounding misses the total",
+			payments:      []string{"333.3333", "333.3333", "333.3333"},
+			expectedTotal: "1000.00",
+			places:        2,
+			want:          []string{"333.34", "333.33", "333.33"},
+		},
+		{
+			name:          "payments already tie out",
+			payments:      []string{"250.00", "250.00"},
+			expectedTotal: "500.00",
+			places:        2,
+			want:          []string{"250", "250"},
+		},
+		{
+			name:          "expected total finer than places",
+			payments:      []string{"250.00", "250.00"},
+			expectedTotal: "500.005",
+			places:        2,
+			wantErr:       true,
+			errorType:     finerrors.ErrInvalidPrecision,
+		},
+		{
+			name:          "payments do not tie out",
+			payments:      []string{"250.00", "250.00"},
+			expectedTotal: "600.00",
+			places:        2,
+			wantErr:       true,
+			errorType:     finerrors.ErrExceedsLimit,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			payments := make([]Decimal, len(tt.payments))
+			for i, p := range tt.payments {
+				payments[i], _ = NewFromString(p)
+			}
+			expectedTotal, _ := NewFromString(tt.expectedTotal)
+
+			got, err := RoundInstallments(payments, expectedTotal, tt.places, rounding.RoundHalfUp)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("RoundInstallments() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				if !errors.Is(err, tt.errorType) {
+					t.Errorf("RoundInstallments() error type = %v, want %v", err, tt.errorType)
+				}
+				return
+			}
+
+			total := Zero()
+			for i, want := range tt.want {
+				if got[i].String() != want {
+					t.Errorf("RoundInstallments()[%d] = %v, want %v", i, got[i].String(), want)
+				}
+				total = total.Add(got[i])
+			}
+			if !total.Equal(expectedTotal) {
+				t.Errorf("RoundInstallments() total = %v, want %v", total.String(), expectedTotal.String())
+			}
+		})
+	}
+}
+
+func TestRoundToIncrement(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     string
+		increment string
+		mode      rounding.Mode
+		want      string
+		wantErr   bool
+		errorType error
+	}{
+		{
+			name:      "nickel half up rounds up",
+			value:     "1.23",
+			increment: "0.05",
+			mode:      rounding.RoundHalfUp,
+			want:      "1.25",
+		},
+		{
+			name:      "nickel half up rounds down",
+			value:     "1.22",
+			increment: "0.05",
+			mode:      rounding.RoundHalfUp,
+			want:      "1.2",
+		},
+		{
+			name:      "nickel midpoint half up",
+			value:     "1.225",
+			increment: "0.05",
+			mode:      rounding.RoundHalfUp,
+			want:      "1.25",
+		},
+		{
+			name:      "nickel midpoint half down",
+			value:     "1.225",
+			increment: "0.05",
+			mode:      rounding.RoundHalfDown,
+			want:      "1.2",
+		},
+		{
+			name:      "nickel midpoint half even",
+			value:     "1.275",
+			increment: "0.05",
+			mode:      rounding.RoundHalfEven,
+			want:      "1.3",
+		},
+		{
+			name:      "negative half up",
+			value:     "-1.225",
+			increment: "0.05",
+			mode:      rounding.RoundHalfUp,
+			want:      "-1.25",
+		},
+		{
+			name:      "negative floor",
+			value:     "-1.21",
+			increment: "0.05",
+			mode:      rounding.RoundFloor,
+			want:      "-1.25",
+		},
+		{
+			name:      "negative ceiling",
+			value:     "-1.24",
+			increment: "0.05",
+			mode:      rounding.RoundCeiling,
+			want:      "-1.2",
+		},
+		{
+			name:      "cash rounding negative is symmetric",
+			value:     "-1.23",
+			increment: "0.05",
+			mode:      rounding.RoundHalfUp,
+			want:      "-1.25",
+		},
+		{
+			name:      "quarter increment",
+			value:     "3.37",
+			increment: "0.25",
+			mode:      rounding.RoundHalfUp,
+			want:      "3.25",
+		},
+		{
+			name:      "increment not dividing a power of ten",
+			value:     "1.00",
+			increment: "0.03",
+			mode:      rounding.RoundHalfUp,
+			want:      "0.99",
+		},
+		{
+			name:      "already a multiple",
+			value:     "2.50",
+			increment: "0.25",
+			mode:      rounding.RoundUp,
+			want:      "2.5",
+		},
+		{
+			name:      "zero increment",
+			value:     "1.23",
+			increment: "0",
+			mode:      rounding.RoundHalfUp,
+			wantErr:   true,
+			errorType: finerrors.ErrInvalidArgument,
+		},
+		{
+			name:      "invalid rounding mode",
+			value:     "1.23",
+			increment: "0.05",
+			mode:      rounding.Mode(99),
+			wantErr:   true,
+			errorType: finerrors.ErrInvalidRounding,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, _ := NewFromString(tt.value)
+			increment, _ := NewFromString(tt.increment)
+
+			got, err := RoundToIncrement(value, increment, tt.mode)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("RoundToIncrement() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				if !errors.Is(err, tt.errorType) {
+					t.Errorf("RoundToIncrement() error = %v, want error type %v", err, tt.errorType)
+				}
+				return
+			}
+			if got.String() != tt.want {
+				t.Errorf("RoundToIncrement() = %v, want %v", got.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestRoundToIncrementWithFloor(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     string
+		increment string
+		floor     string
+		mode      rounding.Mode
+		want      string
+		wantErr   bool
+	}{
+		{
+			name:      "rounding stays above floor",
+			value:     "1.23",
+			increment: "0.05",
+			floor:     "1.00",
+			mode:      rounding.RoundHalfUp,
+			want:      "1.25",
+		},
+		{
+			name:      "rounding drops below floor and clamp engages",
+			value:     "1.22",
+			increment: "0.05",
+			floor:     "1.21",
+			mode:      rounding.RoundHalfUp,
+			want:      "1.21",
+		},
+		{
+			name:      "rounding lands on floor",
+			value:     "1.22",
+			increment: "0.05",
+			floor:     "1.20",
+			mode:      rounding.RoundDown,
+			want:      "1.2",
+		},
+		{
+			name:      "negative increment",
+			value:     "1.22",
+			increment: "-0.05",
+			floor:     "1.00",
+			mode:      rounding.RoundHalfUp,
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, _ := NewFromString(tt.value)
+			increment, _ := NewFromString(tt.increment)
+			floor, _ := NewFromString(tt.floor)
+
+			got, err := RoundToIncrementWithFloor(value, increment, floor, tt.mode)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("RoundToIncrementWithFloor() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && got.String() != tt.want {
+				t.Errorf("RoundToIncrementWithFloor() = %v, want %v", got.String(), tt.want)
+			}
+		})
+	}
+}
@@ -2,6 +2,11 @@
 package safedec
 
 import (
+	"fmt"
+	"math"
+	"math/big"
+	"slices"
+
 	"github.com/shopspring/decimal"
 
 	"github.com/nduyhai/finarith/errors"
@@ -19,13 +24,10 @@ func New(value decimal.Decimal) Decimal {
 	return Decimal{value: value}
 }
 
-// NewFromString creates a new Decimal from a string representation.
+// NewFromString creates a new Decimal from a string representation using the default parser.
+// Inputs longer than DefaultMaxLength are rejected.
 func NewFromString(value string) (Decimal, error) {
-	d, err := decimal.NewFromString(value)
-	if err != nil {
-		return Decimal{}, err
-	}
-	return Decimal{value: d}, nil
+	return NewFromStringWith(value, defaultParser)
 }
 
 // NewFromFloat creates a new Decimal from a float64 value.
@@ -48,22 +50,97 @@ func (d Decimal) String() string {
 	return d.value.String()
 }
 
+// StringFixed returns the string representation of the decimal value with exactly places digits
+// after the decimal point, padding with trailing zeros as needed (e.g. "100.00" for 100 and 2).
+// A value with more decimal places is rounded half away from zero; round it with Round first to
+// use another rounding mode. A negative places returns String(), unpadded and unrounded.
+func (d Decimal) StringFixed(places int32) string {
+	if places < 0 {
+		return d.String()
+	}
+	return d.value.StringFixed(places)
+}
+
 // Float64 returns the float64 representation of the decimal value.
 func (d Decimal) Float64() float64 {
 	f, _ := d.value.Float64()
 	return f
 }
 
+// Float64OrError returns the float64 representation of the decimal value.
+// Returns an error if the float64 differs from the decimal value by more than maxUlpTolerance
+// units in the last place, or if the value is out of the float64 range.
+func (d Decimal) Float64OrError(maxUlpTolerance uint) (float64, error) {
+	f, exact := d.value.Float64()
+	if exact {
+		return f, nil
+	}
+	if math.IsInf(f, 0) {
+		return 0, errors.ErrOverflow
+	}
+
+	// Measure the conversion error in units of the float's last place
+	abs := math.Abs(f)
+	ulp := new(big.Rat).SetFloat64(math.Nextafter(abs, math.Inf(1)) - abs)
+	diff := new(big.Rat).Sub(d.value.Rat(), new(big.Rat).SetFloat64(f))
+	diff.Abs(diff)
+
+	limit := new(big.Rat).Mul(ulp, new(big.Rat).SetUint64(uint64(maxUlpTolerance)))
+	if diff.Cmp(limit) > 0 {
+		return 0, errors.ErrPrecisionLoss
+	}
+
+	return f, nil
+}
+
 // IntPart returns the integer part of the decimal value.
 func (d Decimal) IntPart() int64 {
 	return d.value.IntPart()
 }
 
+// ToRatio expresses the decimal value as an integer fraction with a power-of-ten denominator
+// (e.g. 0.075 becomes 75/1000). The fraction is not reduced.
+// Returns an error if the numerator or the denominator does not fit in an int64.
+func (d Decimal) ToRatio() (numerator, denominator int64, err error) {
+	exp := d.value.Exponent()
+	if exp >= 0 {
+		num := d.value.BigInt()
+		if !num.IsInt64() {
+			return 0, 0, errors.ErrOverflow
+		}
+		return num.Int64(), 1, nil
+	}
+
+	num := d.value.Coefficient()
+	den := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(-exp)), nil)
+	if !num.IsInt64() || !den.IsInt64() {
+		return 0, 0, errors.ErrOverflow
+	}
+	return num.Int64(), den.Int64(), nil
+}
+
 // Equal returns true if the decimal values are equal.
 func (d Decimal) Equal(other Decimal) bool {
 	return d.value.Equal(other.value)
 }
 
+// Cmp compares this decimal value with the other, returning -1 if d < other, 0 if they are
+// equal, and +1 if d > other, following the convention of bytes.Compare and cmp.Compare.
+func (d Decimal) Cmp(other Decimal) int {
+	return d.value.Cmp(other.value)
+}
+
+// EqualAtScale returns true if the decimal values are equal after rounding both
+// to the specified number of decimal places, with ties rounded away from zero.
+func (d Decimal) EqualAtScale(other Decimal, scale int32) bool {
+	return d.value.Round(scale).Equal(other.value.Round(scale))
+}
+
+// EqualCents returns true if the decimal values are equal at money scale (2 decimal places).
+func (d Decimal) EqualCents(other Decimal) bool {
+	return d.EqualAtScale(other, 2)
+}
+
 // GreaterThan returns true if the decimal value is greater than the other.
 func (d Decimal) GreaterThan(other Decimal) bool {
 	return d.value.GreaterThan(other.value)
@@ -99,6 +176,22 @@ func (d Decimal) IsPositive() bool {
 	return d.value.IsPositive()
 }
 
+// IsInteger returns true if the decimal value has no fractional component, so 100 and 1.00 are
+// integers while 1.50 is not.
+func (d Decimal) IsInteger() bool {
+	return d.value.IsInteger()
+}
+
+// DecimalPlaces returns the number of digits after the decimal point as the value is stored,
+// including trailing zeros, so 1.50 has 2 and 100 has 0. A value in exponent notation with no
+// fractional digits, such as 1e3, has 0.
+func (d Decimal) DecimalPlaces() int32 {
+	if exp := d.value.Exponent(); exp < 0 {
+		return -exp
+	}
+	return 0
+}
+
 // Add adds the decimal values and returns a new Decimal.
 func (d Decimal) Add(other Decimal) Decimal {
 	return Decimal{value: d.value.Add(other.value)}
@@ -163,6 +256,33 @@ func (d Decimal) Div(other Decimal) (Decimal, error) {
 	return Decimal{value: d.value.Div(other.value)}, nil
 }
 
+// DivOrZero divides this decimal value by the other and returns a new Decimal,
+// or Zero() if the divisor is zero.
+// It is intended for display purposes only, such as rendering ratios in reports;
+// use Div wherever a zero divisor must be detected.
+func (d Decimal) DivOrZero(other Decimal) Decimal {
+	if other.IsZero() {
+		return Zero()
+	}
+	return Decimal{value: d.value.Div(other.value)}
+}
+
+// Mod returns the modulus of this decimal value by the divisor, computed as
+// d - divisor*floor(d/divisor). Unlike a truncated remainder, the result takes the sign of the
+// divisor, so 7 mod 3 = 1 and -7 mod 3 = 2.
+// Returns ErrDivideByZero if the divisor is zero.
+func (d Decimal) Mod(divisor Decimal) (Decimal, error) {
+	if divisor.IsZero() {
+		return Decimal{}, errors.ErrDivideByZero
+	}
+
+	remainder := d.value.Mod(divisor.value)
+	if !remainder.IsZero() && remainder.Sign() != divisor.value.Sign() {
+		remainder = remainder.Add(divisor.value)
+	}
+	return Decimal{value: remainder}, nil
+}
+
 // DivRound divides this decimal value by the other, rounds to the specified number of decimal places
 // using the specified rounding mode, and returns a new Decimal.
 // Returns an error if the divisor is zero or if the rounding mode is invalid.
@@ -214,6 +334,77 @@ func (d Decimal) Round(places int32, mode rounding.Mode) (Decimal, error) {
 	}
 }
 
+// RoundOrPad returns the decimal value at exactly the specified number of decimal places.
+// If the value has more decimal places than requested it is rounded using the specified
+// rounding mode; if it has fewer it is padded with trailing zeros, so the output scale is
+// always consistent.
+// Returns an error if places is negative or if the rounding mode is invalid.
+func (d Decimal) RoundOrPad(places int32, mode rounding.Mode) (Decimal, error) {
+	if places < 0 {
+		return Decimal{}, errors.ErrInvalidPrecision
+	}
+
+	result := d
+	if places < -d.value.Exponent() {
+		var err error
+		result, err = d.Round(places, mode)
+		if err != nil {
+			return Decimal{}, err
+		}
+	}
+
+	// Rescaling to a wider scale is exact, so this only pads with zeros
+	return Decimal{value: result.value.Round(places)}, nil
+}
+
+// RoundToward rounds the decimal value to the specified number of decimal places in the direction
+// of the reference value: up when the reference is above the value and down when it is below.
+// A value equal to the reference is truncated.
+// Returns an error if places is negative.
+func (d Decimal) RoundToward(reference Decimal, places int32) (Decimal, error) {
+	if places < 0 {
+		return Decimal{}, errors.ErrInvalidPrecision
+	}
+
+	switch {
+	case reference.GreaterThan(d):
+		return d.Round(places, rounding.RoundCeiling)
+	case reference.LessThan(d):
+		return d.Round(places, rounding.RoundFloor)
+	default:
+		return d.Round(places, rounding.RoundDown)
+	}
+}
+
+// RoundToScaleOf returns the decimal value at the same number of decimal places as other, rounding
+// with the specified rounding mode or padding with trailing zeros as needed, so that a computed
+// value lines up with a reference column (e.g. 10.555 at the scale of 1.00 is 10.56 with half up).
+// A reference in exponent notation with no decimal places, such as 1e3, has a scale of zero.
+// Returns an error if the rounding mode is invalid.
+func (d Decimal) RoundToScaleOf(other Decimal, mode rounding.Mode) (Decimal, error) {
+	places := -other.value.Exponent()
+	if places < 0 {
+		places = 0
+	}
+	return d.RoundOrPad(places, mode)
+}
+
+// Floor rounds the decimal toward negative infinity at the specified number of decimal places
+// (e.g. 10.559 is 10.55 and -10.551 is -10.56 at two places). It is shorthand for Round with
+// RoundFloor, which is always a valid mode, so no error is returned.
+func (d Decimal) Floor(places int32) Decimal {
+	result, _ := d.Round(places, rounding.RoundFloor)
+	return result
+}
+
+// Ceil rounds the decimal toward positive infinity at the specified number of decimal places
+// (e.g. 10.551 is 10.56 and -10.559 is -10.55 at two places). It is shorthand for Round with
+// RoundCeiling, which is always a valid mode, so no error is returned.
+func (d Decimal) Ceil(places int32) Decimal {
+	result, _ := d.Round(places, rounding.RoundCeiling)
+	return result
+}
+
 // Abs returns the absolute value of the decimal as a new Decimal.
 func (d Decimal) Abs() Decimal {
 	return Decimal{value: d.value.Abs()}
@@ -224,11 +415,155 @@ func (d Decimal) Neg() Decimal {
 	return Decimal{value: d.value.Neg()}
 }
 
+// Shift multiplies the decimal by 10^places, so positive places scale the value up and negative
+// places scale it down (e.g. shifting 12345 cents by -2 gives 123.45 dollars, and shifting 1.23
+// by 2 gives 123). No rounding is applied; a downward shift keeps every digit.
+func (d Decimal) Shift(places int32) Decimal {
+	return Decimal{value: d.value.Shift(places)}
+}
+
 // Truncate truncates the decimal to the specified number of decimal places.
 func (d Decimal) Truncate(places int32) Decimal {
 	return Decimal{value: d.value.Truncate(places)}
 }
 
+// NormalizeScale returns the decimal value represented with a non-negative scale, that is with
+// at least zero decimal places. shopspring/decimal stores a value as a coefficient times a power of
+// ten and yields a positive exponent (a negative scale) for strings in exponent notation such as
+// "1e3" or "1.5e2", for large floats passed to NewFromFloat, and for products of such values, so
+// "1e3" has coefficient 1 rather than 1000. Values that already have a non-negative scale,
+// including trailing zeros such as "12.30", are returned unchanged.
+func (d Decimal) NormalizeScale() Decimal {
+	if d.value.Exponent() <= 0 {
+		return d
+	}
+	return Decimal{value: decimal.NewFromBigInt(d.value.BigInt(), 0)}
+}
+
+// Clamp returns lower if the decimal value is less than lower, upper if it is greater than upper,
+// and the value itself otherwise.
+// Returns an error wrapping ErrInvalidRange if lower is greater than upper.
+func (d Decimal) Clamp(lower, upper Decimal) (Decimal, error) {
+	if lower.GreaterThan(upper) {
+		return Decimal{}, fmt.Errorf("%w: lower bound %s is greater than upper bound %s", errors.ErrInvalidRange, lower, upper)
+	}
+
+	switch {
+	case d.LessThan(lower):
+		return lower, nil
+	case d.GreaterThan(upper):
+		return upper, nil
+	default:
+		return d, nil
+	}
+}
+
+// Between reports whether the decimal value lies within the range [low, high], inclusive on
+// both ends. It returns false if low is greater than high, since no value lies in that range.
+func (d Decimal) Between(low, high Decimal) bool {
+	return d.GreaterThanOrEqual(low) && d.LessThanOrEqual(high)
+}
+
+// Pow raises this decimal value to the power of exponent and returns a new Decimal.
+// Integer exponents are computed exactly; fractional exponents are approximated with the
+// precision shopspring/decimal derives from the operands. Zero to the power of zero is one.
+// Returns ErrDivideByZero if the value is zero and the exponent is negative, or
+// ErrInvalidArgument if the value is negative and the exponent is not an integer, since the
+// result would be complex, or if the underlying computation fails.
+func (d Decimal) Pow(exponent Decimal) (result Decimal, err error) {
+	if d.IsZero() {
+		switch {
+		case exponent.IsNegative():
+			return Decimal{}, errors.ErrDivideByZero
+		case exponent.IsZero():
+			return One(), nil
+		}
+	}
+	if d.IsNegative() && !exponent.value.IsInteger() {
+		return Decimal{}, errors.ErrInvalidArgument
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			result, err = Decimal{}, fmt.Errorf("%w: %v to the power of %v: %v", errors.ErrInvalidArgument, d, exponent, r)
+		}
+	}()
+	return Decimal{value: d.value.Pow(exponent.value)}, nil
+}
+
+// PowInt raises this decimal value to an integer power and returns a new Decimal, using
+// exponentiation by squaring so only O(log n) multiplications are needed. Non-negative exponents
+// are computed exactly; a negative exponent returns the reciprocal of the positive power, divided
+// with the default division precision. Zero to the power of zero is one.
+// Returns ErrDivideByZero if the value is zero and the exponent is negative.
+// PowInt complements Pow, which takes a Decimal exponent.
+func (d Decimal) PowInt(exponent int64) (Decimal, error) {
+	if d.IsZero() && exponent < 0 {
+		return Decimal{}, errors.ErrDivideByZero
+	}
+
+	// Work with the magnitude of the exponent; -MinInt64 does not fit, but its bits do
+	n := uint64(exponent)
+	if exponent < 0 {
+		n = -n
+	}
+
+	result := decimal.NewFromInt(1)
+	base := d.value
+	for n > 0 {
+		if n%2 == 1 {
+			result = result.Mul(base)
+		}
+		n /= 2
+		if n > 0 {
+			base = base.Mul(base)
+		}
+	}
+
+	if exponent < 0 {
+		return One().Div(Decimal{value: result})
+	}
+	return Decimal{value: result}, nil
+}
+
+// PowDecimal raises this decimal value to the power of exponent, which may be fractional, and
+// rounds the result to the specified number of decimal places using the specified rounding mode.
+// The power is computed by shopspring/decimal with enough extra precision for the rounding to be
+// applied to an accurate value.
+// Returns the same errors as Pow, or an error if the rounding mode is invalid.
+func (d Decimal) PowDecimal(exponent Decimal, places int32, mode rounding.Mode) (Decimal, error) {
+	if d.IsZero() {
+		switch {
+		case exponent.IsNegative():
+			return Decimal{}, errors.ErrDivideByZero
+		case exponent.IsZero():
+			return One().Round(places, mode)
+		}
+	}
+	if d.IsNegative() && !exponent.value.IsInteger() {
+		return Decimal{}, errors.ErrInvalidArgument
+	}
+
+	result, err := d.value.PowWithPrecision(exponent.value, places+16)
+	if err != nil {
+		return Decimal{}, fmt.Errorf("%w: %v to the power of %v: %v", errors.ErrInvalidArgument, d, exponent, err)
+	}
+	return Decimal{value: result}.Round(places, mode)
+}
+
+// Sqrt returns the square root of the decimal value, computed as the value to the power of 0.5
+// with the full precision shopspring/decimal provides; use Round to trim it to a required scale.
+// Returns ErrNegativeValue if the value is negative.
+func (d Decimal) Sqrt() (Decimal, error) {
+	if d.IsNegative() {
+		return Decimal{}, errors.ErrNegativeValue
+	}
+	if d.IsZero() {
+		return Zero(), nil
+	}
+	return d.Pow(Decimal{value: decimal.New(5, -1)})
+}
+
 // Zero returns a decimal with value 0.
 func Zero() Decimal {
 	return Decimal{value: decimal.Zero}
@@ -253,4 +588,55 @@ func MaxValue(a, b Decimal) Decimal {
 		return a
 	}
 	return b
-}
\ No newline at end of file
+}
+
+// Sort sorts the values in ascending order in place. The sort is stable, so equal values at
+// different scales, such as 2.5 and 2.50, keep their original order.
+func Sort(values []Decimal) {
+	slices.SortStableFunc(values, Decimal.Cmp)
+}
+
+// Sum adds the values from left to right and returns the total, or Zero() if there are none.
+// Decimal addition is exact, so Sum cannot fail; use SumWithLimit to enforce a ceiling.
+func Sum(values ...Decimal) Decimal {
+	total := Zero()
+	for _, v := range values {
+		total = total.Add(v)
+	}
+	return total
+}
+
+// SumWithLimit adds the values from left to right and returns the total, or Zero() if there are none.
+// Returns an error wrapping ErrExceedsLimit as soon as the running total exceeds the limit, even if
+// later values would bring it back within the limit.
+func SumWithLimit(limit Decimal, values ...Decimal) (Decimal, error) {
+	total := Zero()
+	for _, v := range values {
+		var err error
+		total, err = total.AddWithLimit(v, limit)
+		if err != nil {
+			return Decimal{}, err
+		}
+	}
+	return total, nil
+}
+
+// Average returns the arithmetic mean of the values, rounded to the specified number of decimal
+// places using the specified rounding mode.
+// Returns ErrDivideByZero if values is empty, or an error if the rounding mode is invalid.
+func Average(values []Decimal, places int32, mode rounding.Mode) (Decimal, error) {
+	if len(values) == 0 {
+		return Decimal{}, errors.ErrDivideByZero
+	}
+	return Sum(values...).DivRound(NewFromInt(int64(len(values))), places, mode)
+}
+
+// Reduce folds the values from left to right, starting from init and replacing the accumulator
+// with f(acc, v) for each value, and returns the final accumulator, or init if there are none.
+func Reduce(values []Decimal, init Decimal, f func(acc, v Decimal) Decimal) Decimal {
+	acc := init
+	for _, v := range values {
+		acc = f(acc, v)
+	}
+	return acc
+}
@@ -2,6 +2,8 @@ package safedec
 
 import (
 	"errors"
+	"math"
+	"sort"
 	"testing"
 
 	finerrors "github.com/nduyhai/finarith/errors"
@@ -175,52 +177,1720 @@ func TestDecimal_String(t *testing.T) {
 	}
 }
 
-func TestDecimal_Mul(t *testing.T) {
+func TestDecimal_StringFixed(t *testing.T) {
 	tests := []struct {
 		name   string
-		value1 string
-		value2 string
+		value  string
+		places int32
 		want   string
 	}{
 		{
-			name:   "simple multiplication",
-			value1: "10.50",
-			value2: "2",
-			want:   "21",
+			name:   "pads an integer",
+			value:  "100",
+			places: 2,
+			want:   "100.00",
 		},
 		{
-			name:   "decimal multiplication",
-			value1: "19.99",
-			value2: "3",
-			want:   "59.97",
+			name:   "pads a trailing zero",
+			value:  "10.5",
+			places: 2,
+			want:   "10.50",
 		},
 		{
-			name:   "negative multiplication",
-			value1: "-10.5",
-			value2: "2",
-			want:   "-21",
+			name:   "rounds half away from zero",
+			value:  "-2.345",
+			places: 2,
+			want:   "-2.35",
+		},
+		{
+			name:   "zero places",
+			value:  "19.99",
+			places: 0,
+			want:   "20",
+		},
+		{
+			name:   "exponent notation",
+			value:  "1.5e2",
+			places: 2,
+			want:   "150.00",
+		},
+		{
+			name:   "negative places returns String",
+			value:  "123.450",
+			places: -1,
+			want:   "123.45",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, _ := NewFromString(tt.value)
+			if got := d.StringFixed(tt.places); got != tt.want {
+				t.Errorf("StringFixed() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimal_ToRatio(t *testing.T) {
+	tests := []struct {
+		name            string
+		value           string
+		wantNumerator   int64
+		wantDenominator int64
+		wantErr         bool
+	}{
+		{
+			name:            "fraction",
+			value:           "0.075",
+			wantNumerator:   75,
+			wantDenominator: 1000,
+		},
+		{
+			name:            "trailing zeros are kept",
+			value:           "1.50",
+			wantNumerator:   150,
+			wantDenominator: 100,
+		},
+		{
+			name:            "negative fraction",
+			value:           "-2.5",
+			wantNumerator:   -25,
+			wantDenominator: 10,
+		},
+		{
+			name:            "integer",
+			value:           "42",
+			wantNumerator:   42,
+			wantDenominator: 1,
+		},
+		{
+			name:            "positive exponent",
+			value:           "4.2e3",
+			wantNumerator:   4200,
+			wantDenominator: 1,
+		},
+		{
+			name:    "denominator overflow",
+			value:   "0.0000000000000000000001",
+			wantErr: true,
+		},
+		{
+			name:    "numerator overflow",
+			value:   "99999999999999999999",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, _ := NewFromString(tt.value)
+			numerator, denominator, err := d.ToRatio()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ToRatio() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				if !errors.Is(err, finerrors.ErrOverflow) {
+					t.Errorf("ToRatio() error is not ErrOverflow: %v", err)
+				}
+				return
+			}
+			if numerator != tt.wantNumerator || denominator != tt.wantDenominator {
+				t.Errorf("ToRatio() = %v/%v, want %v/%v", numerator, denominator, tt.wantNumerator, tt.wantDenominator)
+			}
+		})
+	}
+}
+
+func TestDecimal_IsInteger(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  bool
+	}{
+		{name: "whole number", value: "100", want: true},
+		{name: "zero fraction", value: "1.00", want: true},
+		{name: "fraction", value: "1.50", want: false},
+		{name: "sub-cent", value: "19.999", want: false},
+		{name: "negative whole number", value: "-42", want: true},
+		{name: "negative fraction", value: "-0.5", want: false},
+		{name: "zero", value: "0", want: true},
+		{name: "exponent notation", value: "1.5e2", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, _ := NewFromString(tt.value)
+			if got := d.IsInteger(); got != tt.want {
+				t.Errorf("IsInteger() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimal_DecimalPlaces(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  int32
+	}{
+		{name: "trailing zero", value: "1.50", want: 2},
+		{name: "whole number", value: "100", want: 0},
+		{name: "cents", value: "19.99", want: 2},
+		{name: "sub-cent", value: "-0.125", want: 3},
+		{name: "positive exponent", value: "1e3", want: 0},
+		{name: "negative exponent", value: "1.5e-2", want: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, _ := NewFromString(tt.value)
+			if got := d.DecimalPlaces(); got != tt.want {
+				t.Errorf("DecimalPlaces() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimal_NormalizeScale(t *testing.T) {
+	tests := []struct {
+		name         string
+		value        string
+		wantCoef     string
+		wantExponent int32
+	}{
+		{
+			name:         "exponent notation",
+			value:        "1e3",
+			wantCoef:     "1000",
+			wantExponent: 0,
+		},
+		{
+			name:         "fractional coefficient",
+			value:        "1.5e2",
+			wantCoef:     "150",
+			wantExponent: 0,
+		},
+		{
+			name:         "negative value",
+			value:        "-2E+4",
+			wantCoef:     "-20000",
+			wantExponent: 0,
+		},
+		{
+			name:         "negative exponent unchanged",
+			value:        "1.5e-2",
+			wantCoef:     "15",
+			wantExponent: -3,
+		},
+		{
+			name:         "trailing zeros kept",
+			value:        "12.30",
+			wantCoef:     "1230",
+			wantExponent: -2,
+		},
+		{
+			name:         "integer unchanged",
+			value:        "42",
+			wantCoef:     "42",
+			wantExponent: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, _ := NewFromString(tt.value)
+
+			got := d.NormalizeScale()
+			if !got.Equal(d) {
+				t.Errorf("NormalizeScale() = %v, want value equal to %v", got, d)
+			}
+			if got.Value().Exponent() != tt.wantExponent {
+				t.Errorf("NormalizeScale() exponent = %v, want %v", got.Value().Exponent(), tt.wantExponent)
+			}
+			if got.Value().Coefficient().String() != tt.wantCoef {
+				t.Errorf("NormalizeScale() coefficient = %v, want %v", got.Value().Coefficient(), tt.wantCoef)
+			}
+		})
+	}
+}
+
+func TestDecimal_Clamp(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		min     string
+		max     string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:  "below min",
+			value: "0.12",
+			min:   "0.50",
+			max:   "25.00",
+			want:  "0.5",
+		},
+		{
+			name:  "above max",
+			value: "31.875",
+			min:   "0.50",
+			max:   "25.00",
+			want:  "25",
+		},
+		{
+			name:  "within range",
+			value: "7.25",
+			min:   "0.50",
+			max:   "25.00",
+			want:  "7.25",
+		},
+		{
+			name:  "equal to bound",
+			value: "0.5",
+			min:   "0.50",
+			max:   "25.00",
+			want:  "0.5",
+		},
+		{
+			name:  "degenerate range",
+			value: "3",
+			min:   "1",
+			max:   "1",
+			want:  "1",
+		},
+		{
+			name:    "min greater than max",
+			value:   "7.25",
+			min:     "25.00",
+			max:     "0.50",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, _ := NewFromString(tt.value)
+			min, _ := NewFromString(tt.min)
+			max, _ := NewFromString(tt.max)
+
+			got, err := d.Clamp(min, max)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Clamp() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				if !errors.Is(err, finerrors.ErrInvalidRange) {
+					t.Errorf("Clamp() error = %v, want %v", err, finerrors.ErrInvalidRange)
+				}
+				return
+			}
+			if got.String() != tt.want {
+				t.Errorf("Clamp() = %v, want %v", got.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimal_Between(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		low   string
+		high  string
+		want  bool
+	}{
+		{
+			name:  "inside range",
+			value: "7.25",
+			low:   "0.50",
+			high:  "25.00",
+			want:  true,
+		},
+		{
+			name:  "equal to low",
+			value: "0.5",
+			low:   "0.50",
+			high:  "25.00",
+			want:  true,
+		},
+		{
+			name:  "equal to high",
+			value: "25",
+			low:   "0.50",
+			high:  "25.00",
+			want:  true,
+		},
+		{
+			name:  "below low",
+			value: "0.49",
+			low:   "0.50",
+			high:  "25.00",
+			want:  false,
+		},
+		{
+			name:  "above high",
+			value: "25.01",
+			low:   "0.50",
+			high:  "25.00",
+			want:  false,
+		},
+		{
+			name:  "low greater than high",
+			value: "7.25",
+			low:   "25.00",
+			high:  "0.50",
+			want:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, _ := NewFromString(tt.value)
+			low, _ := NewFromString(tt.low)
+			high, _ := NewFromString(tt.high)
+
+			if got := d.Between(low, high); got != tt.want {
+				t.Errorf("Between() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimal_Cmp(t *testing.T) {
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		want int
+	}{
+		{
+			name: "less than",
+			a:    "1.99",
+			b:    "2",
+			want: -1,
+		},
+		{
+			name: "equal with different scale",
+			a:    "2.50",
+			b:    "2.5",
+			want: 0,
+		},
+		{
+			name: "greater than",
+			a:    "-1",
+			b:    "-1.01",
+			want: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, _ := NewFromString(tt.a)
+			b, _ := NewFromString(tt.b)
+
+			got := a.Cmp(b)
+			if got != tt.want {
+				t.Errorf("Cmp() = %v, want %v", got, tt.want)
+			}
+			if (got == 0) != a.Equal(b) || (got < 0) != a.LessThan(b) || (got > 0) != a.GreaterThan(b) {
+				t.Errorf("Cmp() = %v, inconsistent with Equal %v, LessThan %v, GreaterThan %v", got, a.Equal(b), a.LessThan(b), a.GreaterThan(b))
+			}
+			if b.Cmp(a) != -got {
+				t.Errorf("Cmp() reversed = %v, want %v", b.Cmp(a), -got)
+			}
+		})
+	}
+}
+
+func TestSort(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []string
+		want   []string
+	}{
+		{
+			name:   "empty",
+			values: nil,
+			want:   nil,
+		},
+		{
+			name:   "mixed signs and scales",
+			values: []string{"10.5", "-2", "3.25", "0", "3.2"},
+			want:   []string{"-2", "0", "3.2", "3.25", "10.5"},
+		},
+		{
+			name:   "already sorted",
+			values: []string{"1", "2", "3"},
+			want:   []string{"1", "2", "3"},
+		},
+		{
+			name:   "equal values keep their order",
+			values: []string{"2.50", "1", "2.5", "2.500"},
+			want:   []string{"1", "2.50", "2.5", "2.500"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values := make([]Decimal, len(tt.values))
+			for i, v := range tt.values {
+				values[i], _ = NewFromString(v)
+			}
+
+			Sort(values)
+
+			for i, v := range values {
+				// Compare the unnormalized form to check the order of equal values
+				if got := v.Value().StringFixed(-v.Value().Exponent()); got != tt.want[i] {
+					t.Errorf("Sort()[%d] = %v, want %v", i, got, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestDecimal_CmpSort(t *testing.T) {
+	var prices []Decimal
+	for _, v := range []string{"10.5", "-2", "3.25", "0", "3.2"} {
+		d, _ := NewFromString(v)
+		prices = append(prices, d)
+	}
+
+	sort.Slice(prices, func(i, j int) bool { return prices[i].Cmp(prices[j]) < 0 })
+
+	want := []string{"-2", "0", "3.2", "3.25", "10.5"}
+	for i, p := range prices {
+		if p.String() != want[i] {
+			t.Errorf("sorted[%d] = %v, want %v", i, p.String(), want[i])
+		}
+	}
+}
+
+func TestDecimal_Shift(t *testing.T) {
+	tests := []struct {
+		name   string
+		value  string
+		places int32
+		want   string
+	}{
+		{
+			name:   "cents to dollars",
+			value:  "12345",
+			places: -2,
+			want:   "123.45",
+		},
+		{
+			name:   "dollars to cents",
+			value:  "1.23",
+			places: 2,
+			want:   "123",
+		},
+		{
+			name:   "basis points to percent",
+			value:  "25",
+			places: -4,
+			want:   "0.0025",
+		},
+		{
+			name:   "keeps all digits",
+			value:  "1.005",
+			places: -3,
+			want:   "0.001005",
+		},
+		{
+			name:   "zero places",
+			value:  "42.5",
+			places: 0,
+			want:   "42.5",
+		},
+		{
+			name:   "negative value",
+			value:  "-1.5",
+			places: 1,
+			want:   "-15",
+		},
+		{
+			name:   "zero",
+			value:  "0",
+			places: 5,
+			want:   "0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, _ := NewFromString(tt.value)
+			if got := d.Shift(tt.places).String(); got != tt.want {
+				t.Errorf("Shift() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimal_ShiftRoundTrip(t *testing.T) {
+	d, _ := NewFromString("987.654321")
+	if got := d.Shift(-7).Shift(7); !got.Equal(d) {
+		t.Errorf("Shift(-7).Shift(7) = %v, want %v", got, d)
+	}
+}
+
+func TestDecimal_EqualAtScale(t *testing.T) {
+	tests := []struct {
+		name  string
+		a     string
+		b     string
+		scale int32
+		want  bool
+	}{
+		{
+			name:  "equal after rounding",
+			a:     "10.004",
+			b:     "10.001",
+			scale: 2,
+			want:  true,
+		},
+		{
+			name:  "different after rounding",
+			a:     "10.004",
+			b:     "10.006",
+			scale: 2,
+			want:  false,
+		},
+		{
+			name:  "equal at coarser scale",
+			a:     "10.04",
+			b:     "9.96",
+			scale: 0,
+			want:  true,
+		},
+		{
+			name:  "different at finer scale",
+			a:     "10.004",
+			b:     "10.001",
+			scale: 3,
+			want:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, _ := NewFromString(tt.a)
+			b, _ := NewFromString(tt.b)
+			if got := a.EqualAtScale(b, tt.scale); got != tt.want {
+				t.Errorf("EqualAtScale() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimal_EqualCents(t *testing.T) {
+	a, _ := NewFromString("10.004")
+	b, _ := NewFromString("10.001")
+	c, _ := NewFromString("10.006")
+
+	if !a.EqualCents(b) {
+		t.Errorf("EqualCents(%v, %v) = false, want true", a, b)
+	}
+	if a.EqualCents(c) {
+		t.Errorf("EqualCents(%v, %v) = true, want false", a, c)
+	}
+}
+
+func TestDecimal_Mul(t *testing.T) {
+	tests := []struct {
+		name   string
+		value1 string
+		value2 string
+		want   string
+	}{
+		{
+			name:   "simple multiplication",
+			value1: "10.50",
+			value2: "2",
+			want:   "21",
+		},
+		{
+			name:   "decimal multiplication",
+			value1: "19.99",
+			value2: "3",
+			want:   "59.97",
+		},
+		{
+			name:   "negative multiplication",
+			value1: "-10.5",
+			value2: "2",
+			want:   "-21",
+		},
+		{
+			name:   "zero multiplication",
+			value1: "10.5",
+			value2: "0",
+			want:   "0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d1, _ := NewFromString(tt.value1)
+			d2, _ := NewFromString(tt.value2)
+			result := d1.Mul(d2)
+			if result.String() != tt.want {
+				t.Errorf("Mul() = %v, want %v", result.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimal_Round(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		places  int32
+		mode    rounding.Mode
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "round half up",
+			value:   "10.555",
+			places:  2,
+			mode:    rounding.RoundHalfUp,
+			want:    "10.56",
+			wantErr: false,
+		},
+		{
+			name:    "round half even",
+			value:   "10.555",
+			places:  2,
+			mode:    rounding.RoundHalfEven,
+			want:    "10.56",
+			wantErr: false,
+		},
+		{
+			name:    "round down",
+			value:   "10.555",
+			places:  2,
+			mode:    rounding.RoundDown,
+			want:    "10.55",
+			wantErr: false,
+		},
+		{
+			name:    "round up",
+			value:   "10.551",
+			places:  2,
+			mode:    rounding.RoundUp,
+			want:    "10.56",
+			wantErr: false,
+		},
+		{
+			name:    "invalid rounding mode",
+			value:   "10.555",
+			places:  2,
+			mode:    rounding.Mode(99),
+			want:    "",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, _ := NewFromString(tt.value)
+			result, err := d.Round(tt.places, tt.mode)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Round() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && result.String() != tt.want {
+				t.Errorf("Round() = %v, want %v", result.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimal_RoundToScaleOf(t *testing.T) {
+	tests := []struct {
+		name       string
+		value      string
+		other      string
+		mode       rounding.Mode
+		want       string
+		wantPlaces int32
+		wantErr    bool
+	}{
+		{
+			name:       "align to two places",
+			value:      "10.555",
+			other:      "1.00",
+			mode:       rounding.RoundHalfUp,
+			want:       "10.56",
+			wantPlaces: 2,
+		},
+		{
+			name:       "align to two places half even",
+			value:      "10.565",
+			other:      "1.00",
+			mode:       rounding.RoundHalfEven,
+			want:       "10.56",
+			wantPlaces: 2,
+		},
+		{
+			name:       "pad to a finer scale",
+			value:      "10.5",
+			other:      "0.001",
+			mode:       rounding.RoundHalfUp,
+			want:       "10.500",
+			wantPlaces: 3,
+		},
+		{
+			name:       "align to whole units",
+			value:      "10.5",
+			other:      "7",
+			mode:       rounding.RoundDown,
+			want:       "10",
+			wantPlaces: 0,
+		},
+		{
+			name:       "reference in exponent notation",
+			value:      "10.5",
+			other:      "1e3",
+			mode:       rounding.RoundHalfUp,
+			want:       "11",
+			wantPlaces: 0,
+		},
+		{
+			name:    "invalid rounding mode",
+			value:   "10.555",
+			other:   "1.00",
+			mode:    rounding.Mode(99),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, _ := NewFromString(tt.value)
+			other, _ := NewFromString(tt.other)
+
+			result, err := d.RoundToScaleOf(other, tt.mode)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("RoundToScaleOf() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if result.Value().Exponent() != -tt.wantPlaces {
+				t.Errorf("RoundToScaleOf() exponent = %v, want %v", result.Value().Exponent(), -tt.wantPlaces)
+			}
+			if got := result.Value().StringFixed(tt.wantPlaces); got != tt.want {
+				t.Errorf("RoundToScaleOf() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimal_Floor(t *testing.T) {
+	tests := []struct {
+		name   string
+		value  string
+		places int32
+		want   string
+	}{
+		{
+			name:   "positive",
+			value:  "10.559",
+			places: 2,
+			want:   "10.55",
+		},
+		{
+			name:   "negative",
+			value:  "-10.551",
+			places: 2,
+			want:   "-10.56",
+		},
+		{
+			name:   "already at precision",
+			value:  "10.55",
+			places: 2,
+			want:   "10.55",
+		},
+		{
+			name:   "whole units",
+			value:  "7.9",
+			places: 0,
+			want:   "7",
+		},
+		{
+			name:   "negative whole units",
+			value:  "-7.1",
+			places: 0,
+			want:   "-8",
+		},
+		{
+			name:   "negative places",
+			value:  "1234.5",
+			places: -2,
+			want:   "1200",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, _ := NewFromString(tt.value)
+			if got := d.Floor(tt.places).String(); got != tt.want {
+				t.Errorf("Floor() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimal_Ceil(t *testing.T) {
+	tests := []struct {
+		name   string
+		value  string
+		places int32
+		want   string
+	}{
+		{
+			name:   "positive",
+			value:  "10.551",
+			places: 2,
+			want:   "10.56",
+		},
+		{
+			name:   "negative",
+			value:  "-10.559",
+			places: 2,
+			want:   "-10.55",
+		},
+		{
+			name:   "already at precision",
+			value:  "10.55",
+			places: 2,
+			want:   "10.55",
+		},
+		{
+			name:   "whole units",
+			value:  "7.1",
+			places: 0,
+			want:   "8",
+		},
+		{
+			name:   "negative whole units",
+			value:  "-7.9",
+			places: 0,
+			want:   "-7",
+		},
+		{
+			name:   "negative places",
+			value:  "1234.5",
+			places: -2,
+			want:   "1300",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, _ := NewFromString(tt.value)
+			if got := d.Ceil(tt.places).String(); got != tt.want {
+				t.Errorf("Ceil() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimal_RoundToward(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     string
+		reference string
+		places    int32
+		want      string
+		wantErr   bool
+	}{
+		{
+			name:      "reference below rounds down",
+			value:     "10.555",
+			reference: "10",
+			places:    2,
+			want:      "10.55",
+		},
+		{
+			name:      "reference above rounds up",
+			value:     "10.555",
+			reference: "11",
+			places:    2,
+			want:      "10.56",
+		},
+		{
+			name:      "negative value toward zero",
+			value:     "-10.555",
+			reference: "0",
+			places:    2,
+			want:      "-10.55",
+		},
+		{
+			name:      "negative value away from zero",
+			value:     "-10.555",
+			reference: "-11",
+			places:    2,
+			want:      "-10.56",
+		},
+		{
+			name:      "already at scale",
+			value:     "10.55",
+			reference: "11",
+			places:    2,
+			want:      "10.55",
+		},
+		{
+			name:      "equal to reference",
+			value:     "10.555",
+			reference: "10.555",
+			places:    2,
+			want:      "10.55",
+		},
+		{
+			name:      "negative places",
+			value:     "10.555",
+			reference: "11",
+			places:    -1,
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, _ := NewFromString(tt.value)
+			reference, _ := NewFromString(tt.reference)
+			result, err := d.RoundToward(reference, tt.places)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("RoundToward() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && result.String() != tt.want {
+				t.Errorf("RoundToward() = %v, want %v", result.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimal_DivRound(t *testing.T) {
+	tests := []struct {
+		name    string
+		value1  string
+		value2  string
+		places  int32
+		mode    rounding.Mode
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "simple division",
+			value1:  "10",
+			value2:  "2",
+			places:  2,
+			mode:    rounding.RoundHalfUp,
+			want:    "5",
+			wantErr: false,
+		},
+		{
+			name:    "division with rounding",
+			value1:  "10",
+			value2:  "3",
+			places:  2,
+			mode:    rounding.RoundHalfUp,
+			want:    "3.33",
+			wantErr: false,
+		},
+		{
+			name:    "division by zero",
+			value1:  "10",
+			value2:  "0",
+			places:  2,
+			mode:    rounding.RoundHalfUp,
+			want:    "",
+			wantErr: true,
+		},
+		{
+			name:    "invalid rounding mode",
+			value1:  "10",
+			value2:  "3",
+			places:  2,
+			mode:    rounding.Mode(99),
+			want:    "",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d1, _ := NewFromString(tt.value1)
+			d2, _ := NewFromString(tt.value2)
+			result, err := d1.DivRound(d2, tt.places, tt.mode)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("DivRound() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && result.String() != tt.want {
+				t.Errorf("DivRound() = %v, want %v", result.String(), tt.want)
+			}
+			if err != nil && tt.value2 == "0" && !errors.Is(err, finerrors.ErrDivideByZero) {
+				t.Errorf("DivRound() error is not ErrDivideByZero: %v", err)
+			}
+		})
+	}
+}
+
+func TestDecimal_DivOrZero(t *testing.T) {
+	tests := []struct {
+		name   string
+		value1 string
+		value2 string
+		want   string
+	}{
+		{
+			name:   "simple division",
+			value1: "10",
+			value2: "4",
+			want:   "2.5",
+		},
+		{
+			name:   "negative divisor",
+			value1: "10",
+			value2: "-2",
+			want:   "-5",
+		},
+		{
+			name:   "zero divisor",
+			value1: "10",
+			value2: "0",
+			want:   "0",
+		},
+		{
+			name:   "zero over zero",
+			value1: "0",
+			value2: "0",
+			want:   "0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d1, _ := NewFromString(tt.value1)
+			d2, _ := NewFromString(tt.value2)
+			if got := d1.DivOrZero(d2); got.String() != tt.want {
+				t.Errorf("DivOrZero() = %v, want %v", got.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimal_Mod(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		divisor string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "positive operands",
+			value:   "7",
+			divisor: "3",
+			want:    "1",
+		},
+		{
+			name:    "negative dividend",
+			value:   "-7",
+			divisor: "3",
+			want:    "2",
+		},
+		{
+			name:    "negative divisor",
+			value:   "7",
+			divisor: "-3",
+			want:    "-2",
+		},
+		{
+			name:    "both negative",
+			value:   "-7",
+			divisor: "-3",
+			want:    "-1",
+		},
+		{
+			name:    "exact multiple",
+			value:   "-9",
+			divisor: "3",
+			want:    "0",
+		},
+		{
+			name:    "divisor smaller than one",
+			value:   "10.07",
+			divisor: "0.05",
+			want:    "0.02",
+		},
+		{
+			name:    "negative dividend with divisor smaller than one",
+			value:   "-10.07",
+			divisor: "0.05",
+			want:    "0.03",
+		},
+		{
+			name:    "lot size remainder",
+			value:   "1234.5",
+			divisor: "100",
+			want:    "34.5",
+		},
+		{
+			name:    "zero divisor",
+			value:   "7",
+			divisor: "0",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, _ := NewFromString(tt.value)
+			divisor, _ := NewFromString(tt.divisor)
+
+			got, err := d.Mod(divisor)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Mod() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				if !errors.Is(err, finerrors.ErrDivideByZero) {
+					t.Errorf("Mod() error is not ErrDivideByZero: %v", err)
+				}
+				return
+			}
+			if got.String() != tt.want {
+				t.Errorf("Mod() = %v, want %v", got.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimal_SubNonNegative(t *testing.T) {
+	tests := []struct {
+		name    string
+		value1  string
+		value2  string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "positive result",
+			value1:  "50",
+			value2:  "30",
+			want:    "20",
+			wantErr: false,
+		},
+		{
+			name:    "zero result",
+			value1:  "50",
+			value2:  "50",
+			want:    "0",
+			wantErr: false,
+		},
+		{
+			name:    "negative result",
+			value1:  "50",
+			value2:  "60",
+			want:    "",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d1, _ := NewFromString(tt.value1)
+			d2, _ := NewFromString(tt.value2)
+			result, err := d1.SubNonNegative(d2)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SubNonNegative() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && result.String() != tt.want {
+				t.Errorf("SubNonNegative() = %v, want %v", result.String(), tt.want)
+			}
+			if err != nil && !errors.Is(err, finerrors.ErrNegativeValue) {
+				t.Errorf("SubNonNegative() error is not ErrNegativeValue: %v", err)
+			}
+		})
+	}
+}
+
+func TestDecimal_Float64OrError(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     string
+		tolerance uint
+		want      float64
+		wantErr   bool
+		errorType error
+	}{
+		{
+			name:      "exact value",
+			value:     "0.5",
+			tolerance: 0,
+			want:      0.5,
+		},
+		{
+			name:      "exact integer",
+			value:     "-1024",
+			tolerance: 0,
+			want:      -1024,
+		},
+		{
+			name:      "inexact value with zero tolerance",
+			value:     "0.1",
+			tolerance: 0,
+			wantErr:   true,
+			errorType: finerrors.ErrPrecisionLoss,
+		},
+		{
+			name:      "inexact value within tolerance",
+			value:     "0.1",
+			tolerance: 1,
+			want:      0.1,
+		},
+		{
+			name:      "out of range",
+			value:     "1e400",
+			tolerance: 1,
+			wantErr:   true,
+			errorType: finerrors.ErrOverflow,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, _ := NewFromString(tt.value)
+			got, err := d.Float64OrError(tt.tolerance)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Float64OrError() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil && !errors.Is(err, tt.errorType) {
+				t.Errorf("Float64OrError() error type = %v, want %v", err, tt.errorType)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("Float64OrError() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimal_Pow(t *testing.T) {
+	tests := []struct {
+		name      string
+		base      string
+		exponent  string
+		want      string
+		wantErr   bool
+		errorType error
+	}{
+		{
+			name:     "compound growth factor",
+			base:     "1.05",
+			exponent: "3",
+			want:     "1.157625",
+		},
+		{
+			name:     "integer power",
+			base:     "2",
+			exponent: "10",
+			want:     "1024",
+		},
+		{
+			name:     "negative exponent",
+			base:     "2",
+			exponent: "-2",
+			want:     "0.25",
+		},
+		{
+			name:     "fractional exponent",
+			base:     "4",
+			exponent: "0.5",
+			want:     "2",
+		},
+		{
+			name:     "negative base integer exponent",
+			base:     "-2",
+			exponent: "3",
+			want:     "-8",
+		},
+		{
+			name:     "zero exponent",
+			base:     "123.45",
+			exponent: "0",
+			want:     "1",
+		},
+		{
+			name:     "zero to the power of zero",
+			base:     "0",
+			exponent: "0",
+			want:     "1",
+		},
+		{
+			name:     "zero base positive exponent",
+			base:     "0",
+			exponent: "2",
+			want:     "0",
+		},
+		{
+			name:      "zero base negative exponent",
+			base:      "0",
+			exponent:  "-1",
+			wantErr:   true,
+			errorType: finerrors.ErrDivideByZero,
+		},
+		{
+			name:      "negative base fractional exponent",
+			base:      "-4",
+			exponent:  "0.5",
+			wantErr:   true,
+			errorType: finerrors.ErrInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			base, _ := NewFromString(tt.base)
+			exponent, _ := NewFromString(tt.exponent)
+
+			got, err := base.Pow(exponent)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Pow() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				if !errors.Is(err, tt.errorType) {
+					t.Errorf("Pow() error = %v, want error type %v", err, tt.errorType)
+				}
+				return
+			}
+			if got.String() != tt.want {
+				t.Errorf("Pow() = %v, want %v", got.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimal_PowCompoundInterest(t *testing.T) {
+	principal, _ := NewFromString("1000")
+	rate, _ := NewFromString("0.05")
+
+	factor, err := rate.Add(One()).Pow(NewFromInt(3))
+	if err != nil {
+		t.Fatalf("Pow() error = %v", err)
+	}
+	if got := principal.Mul(factor); got.String() != "1157.625" {
+		t.Errorf("principal * (1 + rate)^3 = %v, want 1157.625", got.String())
+	}
+}
+
+func TestDecimal_PowInt(t *testing.T) {
+	tests := []struct {
+		name     string
+		base     string
+		exponent int64
+		want     string
+		wantErr  bool
+	}{
+		{
+			name:     "compound growth factor",
+			base:     "1.05",
+			exponent: 3,
+			want:     "1.157625",
+		},
+		{
+			name:     "large exponent",
+			base:     "2",
+			exponent: 64,
+			want:     "18446744073709551616",
+		},
+		{
+			name:     "negative exponent",
+			base:     "2",
+			exponent: -2,
+			want:     "0.25",
+		},
+		{
+			name:     "negative exponent of fraction",
+			base:     "0.1",
+			exponent: -3,
+			want:     "1000",
+		},
+		{
+			name:     "negative base odd exponent",
+			base:     "-2",
+			exponent: 3,
+			want:     "-8",
+		},
+		{
+			name:     "min int64 exponent",
+			base:     "-1",
+			exponent: math.MinInt64,
+			want:     "1",
+		},
+		{
+			name:     "zero exponent",
+			base:     "123.45",
+			exponent: 0,
+			want:     "1",
+		},
+		{
+			name:     "zero to the power of zero",
+			base:     "0",
+			exponent: 0,
+			want:     "1",
+		},
+		{
+			name:     "zero base positive exponent",
+			base:     "0",
+			exponent: 5,
+			want:     "0",
+		},
+		{
+			name:     "zero base negative exponent",
+			base:     "0",
+			exponent: -1,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			base, _ := NewFromString(tt.base)
+
+			got, err := base.PowInt(tt.exponent)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PowInt() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				if !errors.Is(err, finerrors.ErrDivideByZero) {
+					t.Errorf("PowInt() error is not ErrDivideByZero: %v", err)
+				}
+				return
+			}
+			if got.String() != tt.want {
+				t.Errorf("PowInt() = %v, want %v", got.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimal_PowDecimal(t *testing.T) {
+	tests := []struct {
+		name      string
+		base      string
+		exponent  string
+		places    int32
+		mode      rounding.Mode
+		want      string
+		wantErr   bool
+		errorType error
+	}{
+		{
+			name:     "square root of two",
+			base:     "2",
+			exponent: "0.5",
+			places:   10,
+			mode:     rounding.RoundHalfUp,
+			want:     "1.4142135624",
+		},
+		{
+			name:     "fractional compounding period",
+			base:     "1.05",
+			exponent: "2.5",
+			places:   8,
+			mode:     rounding.RoundHalfUp,
+			want:     "1.12972632",
+		},
+		{
+			name:     "rounding mode applied",
+			base:     "2",
+			exponent: "0.5",
+			places:   2,
+			mode:     rounding.RoundUp,
+			want:     "1.42",
+		},
+		{
+			name:     "integer exponent",
+			base:     "1.1",
+			exponent: "2",
+			places:   2,
+			mode:     rounding.RoundHalfUp,
+			want:     "1.21",
+		},
+		{
+			name:     "zero base positive exponent",
+			base:     "0",
+			exponent: "1.5",
+			places:   2,
+			mode:     rounding.RoundHalfUp,
+			want:     "0",
+		},
+		{
+			name:     "zero to the power of zero",
+			base:     "0",
+			exponent: "0",
+			places:   2,
+			mode:     rounding.RoundHalfUp,
+			want:     "1",
+		},
+		{
+			name:      "zero base negative exponent",
+			base:      "0",
+			exponent:  "-0.5",
+			places:    2,
+			mode:      rounding.RoundHalfUp,
+			wantErr:   true,
+			errorType: finerrors.ErrDivideByZero,
+		},
+		{
+			name:      "negative base fractional exponent",
+			base:      "-2",
+			exponent:  "0.5",
+			places:    2,
+			mode:      rounding.RoundHalfUp,
+			wantErr:   true,
+			errorType: finerrors.ErrInvalidArgument,
+		},
+		{
+			name:      "invalid rounding mode",
+			base:      "2",
+			exponent:  "0.5",
+			places:    2,
+			mode:      rounding.Mode(99),
+			wantErr:   true,
+			errorType: finerrors.ErrInvalidRounding,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			base, _ := NewFromString(tt.base)
+			exponent, _ := NewFromString(tt.exponent)
+
+			got, err := base.PowDecimal(exponent, tt.places, tt.mode)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PowDecimal() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				if !errors.Is(err, tt.errorType) {
+					t.Errorf("PowDecimal() error = %v, want error type %v", err, tt.errorType)
+				}
+				return
+			}
+			if got.String() != tt.want {
+				t.Errorf("PowDecimal() = %v, want %v", got.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestZero(t *testing.T) {
+	zero := Zero()
+	if !zero.IsZero() {
+		t.Errorf("Zero() = %v, want 0", zero.String())
+	}
+}
+
+func TestOne(t *testing.T) {
+	one := One()
+	if one.String() != "1" {
+		t.Errorf("One() = %v, want 1", one.String())
+	}
+}
+
+func TestMinValue(t *testing.T) {
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		want string
+	}{
+		{
+			name: "a < b",
+			a:    "10",
+			b:    "20",
+			want: "10",
+		},
+		{
+			name: "a > b",
+			a:    "30",
+			b:    "20",
+			want: "20",
+		},
+		{
+			name: "a = b",
+			a:    "20",
+			b:    "20",
+			want: "20",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, _ := NewFromString(tt.a)
+			b, _ := NewFromString(tt.b)
+			result := MinValue(a, b)
+			if result.String() != tt.want {
+				t.Errorf("MinValue() = %v, want %v", result.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestMaxValue(t *testing.T) {
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		want string
+	}{
+		{
+			name: "a < b",
+			a:    "10",
+			b:    "20",
+			want: "20",
+		},
+		{
+			name: "a > b",
+			a:    "30",
+			b:    "20",
+			want: "30",
 		},
 		{
-			name:   "zero multiplication",
-			value1: "10.5",
-			value2: "0",
-			want:   "0",
+			name: "a = b",
+			a:    "20",
+			b:    "20",
+			want: "20",
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			d1, _ := NewFromString(tt.value1)
-			d2, _ := NewFromString(tt.value2)
-			result := d1.Mul(d2)
+			a, _ := NewFromString(tt.a)
+			b, _ := NewFromString(tt.b)
+			result := MaxValue(a, b)
 			if result.String() != tt.want {
-				t.Errorf("Mul() = %v, want %v", result.String(), tt.want)
+				t.Errorf("MaxValue() = %v, want %v", result.String(), tt.want)
 			}
 		})
 	}
 }
 
-func TestDecimal_Round(t *testing.T) {
+func TestDecimal_RoundOrPad(t *testing.T) {
 	tests := []struct {
 		name    string
 		value   string
@@ -230,43 +1900,52 @@ func TestDecimal_Round(t *testing.T) {
 		wantErr bool
 	}{
 		{
-			name:    "round half up",
-			value:   "10.555",
-			places:  2,
-			mode:    rounding.RoundHalfUp,
-			want:    "10.56",
-			wantErr: false,
+			name:   "pad to more places",
+			value:  "10.5",
+			places: 4,
+			mode:   rounding.RoundHalfUp,
+			want:   "10.5000",
 		},
 		{
-			name:    "round half even",
-			value:   "10.555",
-			places:  2,
-			mode:    rounding.RoundHalfEven,
-			want:    "10.56",
-			wantErr: false,
+			name:   "round to fewer places",
+			value:  "10.555",
+			places: 2,
+			mode:   rounding.RoundHalfUp,
+			want:   "10.56",
 		},
 		{
-			name:    "round down",
-			value:   "10.555",
-			places:  2,
-			mode:    rounding.RoundDown,
-			want:    "10.55",
-			wantErr: false,
+			name:   "same places",
+			value:  "10.55",
+			places: 2,
+			mode:   rounding.RoundDown,
+			want:   "10.55",
 		},
 		{
-			name:    "round up",
-			value:   "10.551",
-			places:  2,
-			mode:    rounding.RoundUp,
-			want:    "10.56",
-			wantErr: false,
+			name:   "round ceiling keeps requested scale",
+			value:  "10.500",
+			places: 2,
+			mode:   rounding.RoundCeiling,
+			want:   "10.50",
+		},
+		{
+			name:   "pad integer",
+			value:  "7",
+			places: 2,
+			mode:   rounding.RoundDown,
+			want:   "7.00",
+		},
+		{
+			name:    "negative places",
+			value:   "10.5",
+			places:  -1,
+			mode:    rounding.RoundHalfUp,
+			wantErr: true,
 		},
 		{
 			name:    "invalid rounding mode",
 			value:   "10.555",
 			places:  2,
 			mode:    rounding.Mode(99),
-			want:    "",
 			wantErr: true,
 		},
 	}
@@ -274,223 +1953,342 @@ func TestDecimal_Round(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			d, _ := NewFromString(tt.value)
-			result, err := d.Round(tt.places, tt.mode)
+			result, err := d.RoundOrPad(tt.places, tt.mode)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("Round() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("RoundOrPad() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
-			if !tt.wantErr && result.String() != tt.want {
-				t.Errorf("Round() = %v, want %v", result.String(), tt.want)
+			if tt.wantErr {
+				return
+			}
+			if result.Value().Exponent() != -tt.places {
+				t.Errorf("RoundOrPad() exponent = %v, want %v", result.Value().Exponent(), -tt.places)
+			}
+			if got := result.Value().StringFixed(tt.places); got != tt.want {
+				t.Errorf("RoundOrPad() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
-func TestDecimal_DivRound(t *testing.T) {
+func TestSum(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []string
+		want   string
+	}{
+		{
+			name:   "empty",
+			values: nil,
+			want:   "0",
+		},
+		{
+			name:   "single value",
+			values: []string{"12.34"},
+			want:   "12.34",
+		},
+		{
+			name:   "line items",
+			values: []string{"19.99", "5.01", "0.10"},
+			want:   "25.1",
+		},
+		{
+			name:   "mixed signs",
+			values: []string{"100", "-30.5", "0.5"},
+			want:   "70",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values := make([]Decimal, len(tt.values))
+			for i, v := range tt.values {
+				values[i], _ = NewFromString(v)
+			}
+			if got := Sum(values...); got.String() != tt.want {
+				t.Errorf("Sum() = %v, want %v", got.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestSumWithLimit(t *testing.T) {
 	tests := []struct {
 		name    string
-		value1  string
-		value2  string
-		places  int32
-		mode    rounding.Mode
+		limit   string
+		values  []string
 		want    string
 		wantErr bool
 	}{
 		{
-			name:    "simple division",
-			value1:  "10",
-			value2:  "2",
-			places:  2,
-			mode:    rounding.RoundHalfUp,
-			want:    "5",
-			wantErr: false,
+			name:   "empty",
+			limit:  "100",
+			values: nil,
+			want:   "0",
 		},
 		{
-			name:    "division with rounding",
-			value1:  "10",
-			value2:  "3",
-			places:  2,
-			mode:    rounding.RoundHalfUp,
-			want:    "3.33",
-			wantErr: false,
+			name:   "within limit",
+			limit:  "100",
+			values: []string{"40", "60"},
+			want:   "100",
 		},
 		{
-			name:    "division by zero",
-			value1:  "10",
-			value2:  "0",
-			places:  2,
-			mode:    rounding.RoundHalfUp,
-			want:    "",
+			name:    "exceeds limit",
+			limit:   "100",
+			values:  []string{"40", "60.01"},
 			wantErr: true,
 		},
 		{
-			name:    "invalid rounding mode",
-			value1:  "10",
-			value2:  "3",
-			places:  2,
-			mode:    rounding.Mode(99),
-			want:    "",
+			name:    "exceeds limit midway",
+			limit:   "100",
+			values:  []string{"80", "30", "-50"},
 			wantErr: true,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			d1, _ := NewFromString(tt.value1)
-			d2, _ := NewFromString(tt.value2)
-			result, err := d1.DivRound(d2, tt.places, tt.mode)
+			limit, _ := NewFromString(tt.limit)
+			values := make([]Decimal, len(tt.values))
+			for i, v := range tt.values {
+				values[i], _ = NewFromString(v)
+			}
+
+			got, err := SumWithLimit(limit, values...)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("DivRound() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("SumWithLimit() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
-			if !tt.wantErr && result.String() != tt.want {
-				t.Errorf("DivRound() = %v, want %v", result.String(), tt.want)
+			if err != nil {
+				if !errors.Is(err, finerrors.ErrExceedsLimit) {
+					t.Errorf("SumWithLimit() error is not ErrExceedsLimit: %v", err)
+				}
+				return
 			}
-			if err != nil && tt.value2 == "0" && !errors.Is(err, finerrors.ErrDivideByZero) {
-				t.Errorf("DivRound() error is not ErrDivideByZero: %v", err)
+			if got.String() != tt.want {
+				t.Errorf("SumWithLimit() = %v, want %v", got.String(), tt.want)
 			}
 		})
 	}
 }
 
-func TestDecimal_SubNonNegative(t *testing.T) {
+func TestDecimal_Sqrt(t *testing.T) {
 	tests := []struct {
 		name    string
-		value1  string
-		value2  string
+		value   string
+		places  int32
 		want    string
 		wantErr bool
 	}{
 		{
-			name:    "positive result",
-			value1:  "50",
-			value2:  "30",
-			want:    "20",
-			wantErr: false,
+			name:   "perfect square",
+			value:  "4",
+			places: 8,
+			want:   "2",
 		},
 		{
-			name:    "zero result",
-			value1:  "50",
-			value2:  "50",
-			want:    "0",
-			wantErr: false,
+			name:   "fraction",
+			value:  "0.25",
+			places: 8,
+			want:   "0.5",
 		},
 		{
-			name:    "negative result",
-			value1:  "50",
-			value2:  "60",
-			want:    "",
+			name:   "irrational result",
+			value:  "2",
+			places: 6,
+			want:   "1.414214",
+		},
+		{
+			name:   "large value",
+			value:  "1000000",
+			places: 8,
+			want:   "1000",
+		},
+		{
+			name:   "zero",
+			value:  "0",
+			places: 8,
+			want:   "0",
+		},
+		{
+			name:   "one",
+			value:  "1",
+			places: 8,
+			want:   "1",
+		},
+		{
+			name:    "negative value",
+			value:   "-4",
 			wantErr: true,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			d1, _ := NewFromString(tt.value1)
-			d2, _ := NewFromString(tt.value2)
-			result, err := d1.SubNonNegative(d2)
+			d, _ := NewFromString(tt.value)
+			got, err := d.Sqrt()
 			if (err != nil) != tt.wantErr {
-				t.Errorf("SubNonNegative() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("Sqrt() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
-			if !tt.wantErr && result.String() != tt.want {
-				t.Errorf("SubNonNegative() = %v, want %v", result.String(), tt.want)
+			if err != nil {
+				if !errors.Is(err, finerrors.ErrNegativeValue) {
+					t.Errorf("Sqrt() error is not ErrNegativeValue: %v", err)
+				}
+				return
 			}
-			if err != nil && !errors.Is(err, finerrors.ErrNegativeValue) {
-				t.Errorf("SubNonNegative() error is not ErrNegativeValue: %v", err)
+			rounded, _ := got.Round(tt.places, rounding.RoundHalfUp)
+			if rounded.String() != tt.want {
+				t.Errorf("Sqrt() = %v, want %v at %d places", got.String(), tt.want, tt.places)
 			}
 		})
 	}
 }
 
-func TestZero(t *testing.T) {
-	zero := Zero()
-	if !zero.IsZero() {
-		t.Errorf("Zero() = %v, want 0", zero.String())
-	}
-}
-
-func TestOne(t *testing.T) {
-	one := One()
-	if one.String() != "1" {
-		t.Errorf("One() = %v, want 1", one.String())
-	}
-}
-
-func TestMinValue(t *testing.T) {
+func TestAverage(t *testing.T) {
 	tests := []struct {
-		name string
-		a    string
-		b    string
-		want string
+		name    string
+		values  []string
+		mode    rounding.Mode
+		want    string
+		wantErr bool
 	}{
 		{
-			name: "a < b",
-			a:    "10",
-			b:    "20",
-			want: "10",
+			name:   "single element",
+			values: []string{"12.34"},
+			mode:   rounding.RoundHalfEven,
+			want:   "12.34",
 		},
 		{
-			name: "a > b",
-			a:    "30",
-			b:    "20",
-			want: "20",
+			name:   "even length half even rounds to even",
+			values: []string{"1.00", "1.05"},
+			mode:   rounding.RoundHalfEven,
+			want:   "1.02",
 		},
 		{
-			name: "a = b",
-			a:    "20",
-			b:    "20",
-			want: "20",
+			name:   "even length half up",
+			values: []string{"1.00", "1.05"},
+			mode:   rounding.RoundHalfUp,
+			want:   "1.03",
+		},
+		{
+			name:   "odd length half even",
+			values: []string{"1.00", "1.01", "1.04"},
+			mode:   rounding.RoundHalfEven,
+			want:   "1.02",
+		},
+		{
+			name:   "odd length repeating",
+			values: []string{"10", "10", "11"},
+			mode:   rounding.RoundHalfEven,
+			want:   "10.33",
+		},
+		{
+			name:    "empty",
+			values:  []string{},
+			mode:    rounding.RoundHalfEven,
+			wantErr: true,
+		},
+		{
+			name:    "nil",
+			values:  nil,
+			mode:    rounding.RoundHalfEven,
+			wantErr: true,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			a, _ := NewFromString(tt.a)
-			b, _ := NewFromString(tt.b)
-			result := MinValue(a, b)
-			if result.String() != tt.want {
-				t.Errorf("MinValue() = %v, want %v", result.String(), tt.want)
+			var values []Decimal
+			for _, v := range tt.values {
+				d, _ := NewFromString(v)
+				values = append(values, d)
+			}
+
+			got, err := Average(values, 2, tt.mode)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Average() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				if !errors.Is(err, finerrors.ErrDivideByZero) {
+					t.Errorf("Average() error is not ErrDivideByZero: %v", err)
+				}
+				return
+			}
+			if got.String() != tt.want {
+				t.Errorf("Average() = %v, want %v", got.String(), tt.want)
 			}
 		})
 	}
 }
 
-func TestMaxValue(t *testing.T) {
+func TestReduce(t *testing.T) {
+	sum := func(acc, v Decimal) Decimal { return acc.Add(v) }
+	product := func(acc, v Decimal) Decimal { return acc.Mul(v) }
+
 	tests := []struct {
-		name string
-		a    string
-		b    string
-		want string
+		name   string
+		values []string
+		init   Decimal
+		f      func(acc, v Decimal) Decimal
+		want   string
 	}{
 		{
-			name: "a < b",
-			a:    "10",
-			b:    "20",
-			want: "20",
+			name:   "sum of empty",
+			values: nil,
+			init:   Zero(),
+			f:      sum,
+			want:   "0",
 		},
 		{
-			name: "a > b",
-			a:    "30",
-			b:    "20",
-			want: "30",
+			name:   "sum",
+			values: []string{"19.99", "5.01", "0.10"},
+			init:   Zero(),
+			f:      sum,
+			want:   "25.1",
 		},
 		{
-			name: "a = b",
-			a:    "20",
-			b:    "20",
-			want: "20",
+			name:   "product of empty",
+			values: nil,
+			init:   One(),
+			f:      product,
+			want:   "1",
+		},
+		{
+			name:   "product",
+			values: []string{"1.5", "-2", "0.25"},
+			init:   One(),
+			f:      product,
+			want:   "-0.75",
+		},
+		{
+			name:   "max",
+			values: []string{"3", "-7", "12.5", "12.49"},
+			init:   NewFromInt(-100),
+			f:      MaxValue,
+			want:   "12.5",
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			a, _ := NewFromString(tt.a)
-			b, _ := NewFromString(tt.b)
-			result := MaxValue(a, b)
-			if result.String() != tt.want {
-				t.Errorf("MaxValue() = %v, want %v", result.String(), tt.want)
+			values := make([]Decimal, len(tt.values))
+			for i, v := range tt.values {
+				values[i], _ = NewFromString(v)
+			}
+			if got := Reduce(values, tt.init, tt.f); got.String() != tt.want {
+				t.Errorf("Reduce() = %v, want %v", got.String(), tt.want)
 			}
 		})
 	}
 }
+
+func TestReduce_MatchesSum(t *testing.T) {
+	values := []Decimal{NewFromFloat(0.1), NewFromFloat(0.2), NewFromInt(-3), NewFromFloat(4.75)}
+
+	got := Reduce(values, Zero(), func(acc, v Decimal) Decimal { return acc.Add(v) })
+	if want := Sum(values...); !got.Equal(want) {
+		t.Errorf("Reduce() = %v, want %v", got, want)
+	}
+}
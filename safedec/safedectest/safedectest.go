@@ -0,0 +1,96 @@
+// Package safedectest provides test helpers for code built on safedec.
+package safedectest
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/nduyhai/finarith/rounding"
+	"github.com/nduyhai/finarith/safedec"
+)
+
+// roundFunc rounds a value to the given number of places using the given mode.
+type roundFunc func(value safedec.Decimal, places int32, mode rounding.Mode) (safedec.Decimal, error)
+
+// CheckRoundingInvariants reports a test error for each property that Decimal.Round violates when
+// rounding value to the specified number of decimal places using the specified rounding mode:
+//   - rounding succeeds and the result has at most places decimal places
+//   - rounding the result again to the same places leaves it unchanged
+//   - the result is less than one unit at that scale from value, and within half a unit for the
+//     round-to-nearest modes
+//   - the result lies on the side of value that the mode requires (toward zero, away from zero,
+//     toward positive infinity, or toward negative infinity)
+//   - for those directed modes, rounding to one more place first and then to places gives the
+//     same result as rounding directly (round-to-nearest modes are exempt, since double rounding
+//     legitimately differs for them, e.g. 1.445 -> 1.45 -> 1.5)
+func CheckRoundingInvariants(t testing.TB, value safedec.Decimal, places int32, mode rounding.Mode) {
+	t.Helper()
+	checkRoundingInvariants(t, value, places, mode, safedec.Decimal.Round)
+}
+
+// checkRoundingInvariants checks the invariants documented on CheckRoundingInvariants against
+// the given rounding implementation.
+func checkRoundingInvariants(t testing.TB, value safedec.Decimal, places int32, mode rounding.Mode, round roundFunc) {
+	t.Helper()
+
+	rounded, err := round(value, places, mode)
+	if err != nil {
+		t.Errorf("Round(%v, %d, %v) error = %v", value, places, mode, err)
+		return
+	}
+
+	if !rounded.Truncate(places).Equal(rounded) {
+		t.Errorf("Round(%v, %d, %v) = %v, has more than %d decimal places", value, places, mode, rounded, places)
+	}
+
+	again, err := round(rounded, places, mode)
+	if err != nil || !again.Equal(rounded) {
+		t.Errorf("Round(Round(%v, %d, %v)) = %v, want idempotent result %v", value, places, mode, again, rounded)
+	}
+
+	unit := safedec.New(decimal.New(1, -places))
+	diff := rounded.Sub(value).Abs()
+	if !diff.LessThan(unit) {
+		t.Errorf("Round(%v, %d, %v) = %v, differs from value by %v, want less than %v", value, places, mode, rounded, diff, unit)
+	}
+
+	var directed bool
+	switch mode {
+	case rounding.RoundDown:
+		directed = true
+		if rounded.Abs().GreaterThan(value.Abs()) {
+			t.Errorf("Round(%v, %d, %v) = %v, want rounding toward zero", value, places, mode, rounded)
+		}
+	case rounding.RoundUp:
+		directed = true
+		if rounded.Abs().LessThan(value.Abs()) {
+			t.Errorf("Round(%v, %d, %v) = %v, want rounding away from zero", value, places, mode, rounded)
+		}
+	case rounding.RoundCeiling:
+		directed = true
+		if rounded.LessThan(value) {
+			t.Errorf("Round(%v, %d, %v) = %v, want rounding toward positive infinity", value, places, mode, rounded)
+		}
+	case rounding.RoundFloor:
+		directed = true
+		if rounded.GreaterThan(value) {
+			t.Errorf("Round(%v, %d, %v) = %v, want rounding toward negative infinity", value, places, mode, rounded)
+		}
+	default:
+		half := unit.Mul(safedec.New(decimal.NewFromFloat(0.5)))
+		if diff.GreaterThan(half) {
+			t.Errorf("Round(%v, %d, %v) = %v, differs from value by %v, want at most %v", value, places, mode, rounded, diff, half)
+		}
+	}
+
+	if directed {
+		finer, err := round(value, places+1, mode)
+		if err == nil {
+			finer, err = round(finer, places, mode)
+		}
+		if err != nil || !finer.Equal(rounded) {
+			t.Errorf("Round(Round(%v, %d, %v), %d) = %v, want %v", value, places+1, mode, places, finer, rounded)
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package safedectest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/nduyhai/finarith/rounding"
+	"github.com/nduyhai/finarith/safedec"
+)
+
+// recordingTB records reported errors instead of failing the test.
+type recordingTB struct {
+	testing.TB
+	errors []string
+}
+
+func (r *recordingTB) Helper() {}
+
+func (r *recordingTB) Errorf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func TestCheckRoundingInvariants(t *testing.T) {
+	values := []string{"0", "1.005", "-1.005", "2.345", "-2.345", "123.456789", "-0.0049", "1.445", "7"}
+	modes := []rounding.Mode{
+		rounding.RoundDown,
+		rounding.RoundUp,
+		rounding.RoundHalfUp,
+		rounding.RoundHalfEven,
+		rounding.RoundCeiling,
+		rounding.RoundFloor,
+	}
+
+	for _, mode := range modes {
+		for _, v := range values {
+			for _, places := range []int32{0, 2} {
+				t.Run(fmt.Sprintf("%v/%s/%d", mode, v, places), func(t *testing.T) {
+					value, _ := safedec.NewFromString(v)
+					CheckRoundingInvariants(t, value, places, mode)
+				})
+			}
+		}
+	}
+}
+
+func TestCheckRoundingInvariants_FlagsBrokenMode(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		mode  rounding.Mode
+		round roundFunc
+	}{
+		{
+			name:  "floor that rounds up",
+			value: "1.234",
+			mode:  rounding.RoundFloor,
+			round: func(value safedec.Decimal, places int32, _ rounding.Mode) (safedec.Decimal, error) {
+				return value.Round(places, rounding.RoundCeiling)
+			},
+		},
+		{
+			name:  "half up that truncates",
+			value: "1.239",
+			mode:  rounding.RoundHalfUp,
+			round: func(value safedec.Decimal, places int32, _ rounding.Mode) (safedec.Decimal, error) {
+				return value.Truncate(places), nil
+			},
+		},
+		{
+			name:  "round that ignores places",
+			value: "1.23456",
+			mode:  rounding.RoundHalfUp,
+			round: func(value safedec.Decimal, _ int32, mode rounding.Mode) (safedec.Decimal, error) {
+				return value.Round(4, mode)
+			},
+		},
+		{
+			name:  "round that is not idempotent",
+			value: "1.5",
+			mode:  rounding.RoundUp,
+			round: func(value safedec.Decimal, places int32, mode rounding.Mode) (safedec.Decimal, error) {
+				return value.Add(safedec.One()).Round(places, mode)
+			},
+		},
+		{
+			name:  "unsupported mode",
+			value: "1.5",
+			mode:  rounding.Mode(99),
+			round: safedec.Decimal.Round,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := &recordingTB{TB: t}
+			value, _ := safedec.NewFromString(tt.value)
+
+			checkRoundingInvariants(rec, value, 2, tt.mode, tt.round)
+			if len(rec.errors) == 0 {
+				t.Errorf("checkRoundingInvariants() reported no errors for a broken rounding implementation")
+			}
+		})
+	}
+}
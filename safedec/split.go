@@ -0,0 +1,360 @@
+package safedec
+
+import (
+	"math/big"
+	"sort"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/nduyhai/finarith/errors"
+	"github.com/nduyhai/finarith/rounding"
+)
+
+// SplitByAmounts fills each of the requested target amounts in order from the decimal value,
+// returning the allocated amount for each target and any unallocated remainder.
+// Once the total runs short, the current target receives what is left and later targets receive zero.
+// Returns an error if the total or any of the targets is negative.
+func (d Decimal) SplitByAmounts(targets []Decimal) (allocated []Decimal, remainder Decimal, err error) {
+	if d.IsNegative() {
+		return nil, Decimal{}, errors.ErrNegativeValue
+	}
+
+	allocated = make([]Decimal, len(targets))
+	remainder = d
+	for i, target := range targets {
+		if target.IsNegative() {
+			return nil, Decimal{}, errors.ErrNegativeValue
+		}
+
+		allocated[i] = MinValue(target, remainder)
+		remainder = remainder.Sub(allocated[i])
+	}
+
+	return allocated, remainder, nil
+}
+
+// SplitRotating splits the decimal value into n parts at its own scale (e.g. cents for 10.00).
+// The parts differ by at most one smallest unit, and the leftover units are assigned one per part
+// starting at index seed mod n, so varying the seed across repeated splits rotates the advantage.
+// The parts always sum to the original value.
+// Returns an error if n is not positive.
+func (d Decimal) SplitRotating(n int, seed int) ([]Decimal, error) {
+	if n <= 0 {
+		return nil, errors.ErrInvalidArgument
+	}
+
+	places := -d.value.Exponent()
+	if places < 0 {
+		places = 0
+	}
+
+	// Work in whole units of the value's scale
+	units := d.value.Shift(places).BigInt()
+	quotient, remainder := new(big.Int).QuoRem(units, big.NewInt(int64(n)), new(big.Int))
+
+	base := decimal.NewFromBigInt(quotient, -places)
+	unit := decimal.New(int64(remainder.Sign()), -places)
+	leftover := int(new(big.Int).Abs(remainder).Int64())
+
+	start := seed % n
+	if start < 0 {
+		start += n
+	}
+
+	parts := make([]Decimal, n)
+	for i := range parts {
+		parts[i] = Decimal{value: base}
+	}
+	for i := 0; i < leftover; i++ {
+		idx := (start + i) % n
+		parts[idx] = Decimal{value: base.Add(unit)}
+	}
+
+	return parts, nil
+}
+
+// Apportion distributes total across buckets in proportion to weights, without allocating more
+// than caps[i] to bucket i. The share of a bucket that reaches its cap is redistributed among the
+// remaining buckets by weight, and whatever cannot be allocated because every bucket with a positive
+// weight is full is returned as the remainder. The allocations plus the remainder always sum to total.
+// Returns an error if the slices differ in length or if the total, a weight, or a cap is negative.
+func Apportion(total Decimal, weights []Decimal, caps []Decimal) (allocated []Decimal, remainder Decimal, err error) {
+	if len(weights) != len(caps) {
+		return nil, Decimal{}, errors.ErrInvalidArgument
+	}
+	if total.IsNegative() {
+		return nil, Decimal{}, errors.ErrNegativeValue
+	}
+
+	open := make([]int, 0, len(weights))
+	for i := range weights {
+		if weights[i].IsNegative() || caps[i].IsNegative() {
+			return nil, Decimal{}, errors.ErrNegativeValue
+		}
+		if weights[i].IsPositive() {
+			open = append(open, i)
+		}
+	}
+
+	allocated = make([]Decimal, len(weights))
+	for i := range allocated {
+		allocated[i] = Zero()
+	}
+
+	remainder = total
+	for len(open) > 0 && remainder.IsPositive() {
+		weightSum := Zero()
+		for _, i := range open {
+			weightSum = weightSum.Add(weights[i])
+		}
+
+		shares := make([]Decimal, len(open))
+		stillOpen := make([]int, 0, len(open))
+		for k, i := range open {
+			shares[k], err = remainder.Mul(weights[i]).Div(weightSum)
+			if err != nil {
+				return nil, Decimal{}, err
+			}
+			if shares[k].LessThan(caps[i]) {
+				stillOpen = append(stillOpen, i)
+			}
+		}
+
+		// Fill the buckets whose share reaches their cap and redistribute what is left
+		if len(stillOpen) < len(open) {
+			for k, i := range open {
+				if shares[k].GreaterThanOrEqual(caps[i]) {
+					allocated[i] = caps[i]
+					remainder = remainder.Sub(caps[i])
+				}
+			}
+			open = stillOpen
+			continue
+		}
+
+		// No bucket is capped, so the shares are final; the last bucket absorbs the
+		// residue of the division so that the allocations sum exactly
+		last := len(open) - 1
+		for k, i := range open[:last] {
+			allocated[i] = shares[k]
+			remainder = remainder.Sub(shares[k])
+		}
+		i := open[last]
+		allocated[i] = MinValue(remainder, caps[i])
+		remainder = remainder.Sub(allocated[i])
+		break
+	}
+
+	return allocated, remainder, nil
+}
+
+// Allocate distributes the decimal value across buckets in proportion to the integer ratios,
+// at the given number of decimal places. Each bucket receives its share rounded down to the
+// smallest unit at that precision, and the leftover units are assigned one each to the earliest
+// buckets with a non-zero ratio, so the parts always sum exactly to the original value. Use the
+// package-level Allocate to give the leftover units to the buckets with the largest remainders.
+// Returns ErrEmptyInput if ratios is empty, ErrNegativeValue if the value is negative,
+// ErrInvalidArgument if a ratio is negative or all ratios are zero, or ErrInvalidPrecision if the
+// value has more decimal places than places.
+func (d Decimal) Allocate(ratios []int, places int32) ([]Decimal, error) {
+	if len(ratios) == 0 {
+		return nil, errors.ErrEmptyInput
+	}
+	if d.IsNegative() {
+		return nil, errors.ErrNegativeValue
+	}
+	if !d.value.Equal(d.value.Truncate(places)) {
+		return nil, errors.ErrInvalidPrecision
+	}
+
+	ratioSum := new(big.Int)
+	for _, r := range ratios {
+		if r < 0 {
+			return nil, errors.ErrInvalidArgument
+		}
+		ratioSum.Add(ratioSum, big.NewInt(int64(r)))
+	}
+	if ratioSum.Sign() == 0 {
+		return nil, errors.ErrInvalidArgument
+	}
+
+	// Work in whole units of the requested precision
+	units := d.value.Shift(places).BigInt()
+	shares := make([]*big.Int, len(ratios))
+	leftover := new(big.Int).Set(units)
+	for i, r := range ratios {
+		shares[i] = new(big.Int).Mul(units, big.NewInt(int64(r)))
+		shares[i].Quo(shares[i], ratioSum)
+		leftover.Sub(leftover, shares[i])
+	}
+
+	one := big.NewInt(1)
+	for i := 0; leftover.Sign() > 0; i++ {
+		if ratios[i] == 0 {
+			continue
+		}
+		shares[i].Add(shares[i], one)
+		leftover.Sub(leftover, one)
+	}
+
+	parts := make([]Decimal, len(ratios))
+	for i, share := range shares {
+		parts[i] = Decimal{value: decimal.NewFromBigInt(share, -places)}
+	}
+	return parts, nil
+}
+
+// Split divides the decimal value into n equal parts at the given number of decimal places,
+// assigning leftover smallest units to the earliest parts so the parts sum exactly to the
+// original value.
+// Returns ErrInvalidArgument if n is not positive, and otherwise the same errors as Allocate.
+func (d Decimal) Split(n int, places int32) ([]Decimal, error) {
+	if n <= 0 {
+		return nil, errors.ErrInvalidArgument
+	}
+
+	ratios := make([]int, n)
+	for i := range ratios {
+		ratios[i] = 1
+	}
+	return d.Allocate(ratios, places)
+}
+
+// SplitWhole divides the decimal value into n parts of equal whole amounts, each the value
+// divided by n rounded down to a whole unit, and returns what is left over as the remainder.
+// The parts and the remainder always sum to the original value.
+// Returns ErrInvalidArgument if n is not positive, or ErrNegativeValue if the value is negative.
+func (d Decimal) SplitWhole(n int) (parts []Decimal, remainder Decimal, err error) {
+	if n <= 0 {
+		return nil, Decimal{}, errors.ErrInvalidArgument
+	}
+	if d.IsNegative() {
+		return nil, Decimal{}, errors.ErrNegativeValue
+	}
+
+	whole, rest := d.value.QuoRem(decimal.NewFromInt(int64(n)), 0)
+
+	parts = make([]Decimal, n)
+	for i := range parts {
+		parts[i] = Decimal{value: whole}
+	}
+	return parts, Decimal{value: rest}, nil
+}
+
+// SplitEqual divides total into n equal shares at the scale of total (e.g. cents for 10.00), each
+// share being total / n rounded with the specified rounding mode, and returns what the shares
+// leave over or overshoot as the remainder, so that callers decide where it is assigned. The
+// shares plus the remainder always sum exactly to total; the remainder is negative when rounding
+// the shares up overshoots the total.
+// Returns ErrInvalidArgument if n is not positive, or an error if the rounding mode is invalid.
+func SplitEqual(total Decimal, n int, mode rounding.Mode) (shares []Decimal, remainder Decimal, err error) {
+	if n <= 0 {
+		return nil, Decimal{}, errors.ErrInvalidArgument
+	}
+
+	places := -total.value.Exponent()
+	if places < 0 {
+		places = 0
+	}
+
+	count := NewFromInt(int64(n))
+	share, err := total.DivRound(count, places, mode)
+	if err != nil {
+		return nil, Decimal{}, err
+	}
+
+	shares = make([]Decimal, n)
+	for i := range shares {
+		shares[i] = share
+	}
+	return shares, total.Sub(share.Mul(count)), nil
+}
+
+// Allocate distributes total across len(ratios) buckets in proportion to the ratios, at the scale
+// of total (e.g. cents for 10.00). Each bucket receives its exact share truncated to that scale, and
+// the leftover units are assigned one each to the buckets with the largest truncated remainders,
+// earliest first on ties, so the parts always sum exactly to total. Unlike the Allocate method,
+// which gives the leftover units to the earliest buckets, the ratios may be fractional and the
+// leftover follows the largest remainders rather than bucket order.
+// Returns ErrEmptyInput if ratios is empty, ErrNegativeValue if a ratio is negative, or
+// ErrInvalidArgument if the ratios sum to zero.
+func Allocate(total Decimal, ratios []Decimal) ([]Decimal, error) {
+	if len(ratios) == 0 {
+		return nil, errors.ErrEmptyInput
+	}
+
+	// Scale the ratios to integers so that every share can be computed exactly
+	var ratioPlaces int32
+	for _, r := range ratios {
+		if r.IsNegative() {
+			return nil, errors.ErrNegativeValue
+		}
+		if p := -r.value.Exponent(); p > ratioPlaces {
+			ratioPlaces = p
+		}
+	}
+	weights := make([]*big.Int, len(ratios))
+	weightSum := new(big.Int)
+	for i, r := range ratios {
+		weights[i] = r.value.Shift(ratioPlaces).BigInt()
+		weightSum.Add(weightSum, weights[i])
+	}
+	if weightSum.Sign() == 0 {
+		return nil, errors.ErrInvalidArgument
+	}
+
+	places := -total.value.Exponent()
+	if places < 0 {
+		places = 0
+	}
+
+	// Work in whole units of the total's scale, allocating the magnitude and restoring the sign after
+	units := total.value.Shift(places).BigInt()
+	sign := units.Sign()
+	units.Abs(units)
+
+	shares := make([]*big.Int, len(ratios))
+	remainders := make([]*big.Int, len(ratios))
+	leftover := new(big.Int).Set(units)
+	for i, w := range weights {
+		shares[i], remainders[i] = new(big.Int).QuoRem(new(big.Int).Mul(units, w), weightSum, new(big.Int))
+		leftover.Sub(leftover, shares[i])
+	}
+
+	order := make([]int, len(ratios))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return remainders[order[a]].Cmp(remainders[order[b]]) > 0
+	})
+
+	// The leftover is less than the number of buckets with a non-zero remainder
+	for k := int64(0); k < leftover.Int64(); k++ {
+		shares[order[k]].Add(shares[order[k]], big.NewInt(1))
+	}
+
+	parts := make([]Decimal, len(ratios))
+	for i, share := range shares {
+		if sign < 0 {
+			share.Neg(share)
+		}
+		parts[i] = Decimal{value: decimal.NewFromBigInt(share, -places)}
+	}
+	return parts, nil
+}
+
+// AllocateEqual distributes total across n buckets in equal proportions with Allocate, so the
+// parts differ by at most one unit at the scale of total and sum exactly to total.
+// Returns ErrInvalidArgument if n is not positive.
+func AllocateEqual(total Decimal, n int) ([]Decimal, error) {
+	if n <= 0 {
+		return nil, errors.ErrInvalidArgument
+	}
+
+	ratios := make([]Decimal, n)
+	for i := range ratios {
+		ratios[i] = One()
+	}
+	return Allocate(total, ratios)
+}
@@ -0,0 +1,914 @@
+package safedec
+
+import (
+	"errors"
+	"testing"
+
+	finerrors "github.com/nduyhai/finarith/errors"
+	"github.com/nduyhai/finarith/rounding"
+)
+
+func TestDecimal_SplitByAmounts(t *testing.T) {
+	tests := []struct {
+		name          string
+		total         string
+		targets       []string
+		wantAllocated []string
+		wantRemainder string
+		wantErr       bool
+	}{
+		{
+			name:          "total covers all targets",
+			total:         "100.00",
+			targets:       []string{"30.00", "20.50", "10.00"},
+			wantAllocated: []string{"30", "20.5", "10"},
+			wantRemainder: "39.5",
+		},
+		{
+			name:          "total exactly matches targets",
+			total:         "60.00",
+			targets:       []string{"30.00", "30.00"},
+			wantAllocated: []string{"30", "30"},
+			wantRemainder: "0",
+		},
+		{
+			name:          "total runs short mid-list",
+			total:         "50.00",
+			targets:       []string{"30.00", "40.00", "10.00"},
+			wantAllocated: []string{"30", "20", "0"},
+			wantRemainder: "0",
+		},
+		{
+			name:          "no targets",
+			total:         "50.00",
+			targets:       []string{},
+			wantAllocated: []string{},
+			wantRemainder: "50",
+		},
+		{
+			name:    "negative target",
+			total:   "50.00",
+			targets: []string{"10.00", "-5.00"},
+			wantErr: true,
+		},
+		{
+			name:    "negative total",
+			total:   "-50.00",
+			targets: []string{"10.00"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			total, _ := NewFromString(tt.total)
+			targets := make([]Decimal, len(tt.targets))
+			for i, target := range tt.targets {
+				targets[i], _ = NewFromString(target)
+			}
+
+			allocated, remainder, err := total.SplitByAmounts(targets)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SplitByAmounts() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				if !errors.Is(err, finerrors.ErrNegativeValue) {
+					t.Errorf("SplitByAmounts() error is not ErrNegativeValue: %v", err)
+				}
+				return
+			}
+
+			if len(allocated) != len(tt.wantAllocated) {
+				t.Fatalf("SplitByAmounts() returned %d parts, want %d", len(allocated), len(tt.wantAllocated))
+			}
+			for i, want := range tt.wantAllocated {
+				if allocated[i].String() != want {
+					t.Errorf("SplitByAmounts() allocated[%d] = %v, want %v", i, allocated[i].String(), want)
+				}
+			}
+			if remainder.String() != tt.wantRemainder {
+				t.Errorf("SplitByAmounts() remainder = %v, want %v", remainder.String(), tt.wantRemainder)
+			}
+		})
+	}
+}
+
+func TestDecimal_SplitRotating(t *testing.T) {
+	tests := []struct {
+		name    string
+		total   string
+		n       int
+		seed    int
+		want    []string
+		wantErr bool
+	}{
+		{
+			name:  "extra cent at seed index",
+			total: "10.00",
+			n:     3,
+			seed:  0,
+			want:  []string{"3.34", "3.33", "3.33"},
+		},
+		{
+			name:  "extra cent rotated",
+			total: "10.00",
+			n:     3,
+			seed:  1,
+			want:  []string{"3.33", "3.34", "3.33"},
+		},
+		{
+			name:  "seed wraps around",
+			total: "10.00",
+			n:     3,
+			seed:  5,
+			want:  []string{"3.33", "3.33", "3.34"},
+		},
+		{
+			name:  "multiple leftover units wrap",
+			total: "0.05",
+			n:     3,
+			seed:  2,
+			want:  []string{"0.02", "0.01", "0.02"},
+		},
+		{
+			name:  "negative seed",
+			total: "10.00",
+			n:     3,
+			seed:  -1,
+			want:  []string{"3.33", "3.33", "3.34"},
+		},
+		{
+			name:  "negative total",
+			total: "-10.00",
+			n:     3,
+			seed:  0,
+			want:  []string{"-3.34", "-3.33", "-3.33"},
+		},
+		{
+			name:  "whole units",
+			total: "10",
+			n:     4,
+			seed:  3,
+			want:  []string{"3", "2", "2", "3"},
+		},
+		{
+			name:    "zero parts",
+			total:   "10.00",
+			n:       0,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			total, _ := NewFromString(tt.total)
+
+			got, err := total.SplitRotating(tt.n, tt.seed)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SplitRotating() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				if !errors.Is(err, finerrors.ErrInvalidArgument) {
+					t.Errorf("SplitRotating() error type = %v, want %v", err, finerrors.ErrInvalidArgument)
+				}
+				return
+			}
+
+			sum := Zero()
+			for i, want := range tt.want {
+				if got[i].String() != want {
+					t.Errorf("SplitRotating()[%d] = %v, want %v", i, got[i].String(), want)
+				}
+				sum = sum.Add(got[i])
+			}
+			if !sum.Equal(total) {
+				t.Errorf("SplitRotating() sum = %v, want %v", sum.String(), total.String())
+			}
+		})
+	}
+}
+
+func TestApportion(t *testing.T) {
+	tests := []struct {
+		name          string
+		total         string
+		weights       []string
+		caps          []string
+		wantAllocated []string
+		wantRemainder string
+		wantErr       bool
+		errorType     error
+	}{
+		{
+			name:          "no caps reached",
+			total:         "100",
+			weights:       []string{"1", "1", "2"},
+			caps:          []string{"100", "100", "100"},
+			wantAllocated: []string{"25", "25", "50"},
+			wantRemainder: "0",
+		},
+		{
+			name:          "capped bucket overflow redistributes",
+			total:         "100",
+			weights:       []string{"1", "1", "2"},
+			caps:          []string{"10", "100", "100"},
+			wantAllocated: []string{"10", "30", "60"},
+			wantRemainder: "0",
+		},
+		{
+			name:          "redistribution caps a second bucket",
+			total:         "100",
+			weights:       []string{"1", "1", "2"},
+			caps:          []string{"10", "100", "55"},
+			wantAllocated: []string{"10", "35", "55"},
+			wantRemainder: "0",
+		},
+		{
+			name:          "all buckets capped leaves remainder",
+			total:         "100",
+			weights:       []string{"1", "1", "2"},
+			caps:          []string{"10", "20", "30"},
+			wantAllocated: []string{"10", "20", "30"},
+			wantRemainder: "40",
+		},
+		{
+			name:          "zero weight bucket receives nothing",
+			total:         "90",
+			weights:       []string{"0", "1", "2"},
+			caps:          []string{"100", "100", "100"},
+			wantAllocated: []string{"0", "30", "60"},
+			wantRemainder: "0",
+		},
+		{
+			name:          "uneven division sums exactly",
+			total:         "100",
+			weights:       []string{"1", "1", "1"},
+			caps:          []string{"50", "50", "50"},
+			wantAllocated: []string{"33.3333333333333333", "33.3333333333333333", "33.3333333333333334"},
+			wantRemainder: "0",
+		},
+		{
+			name:          "empty",
+			total:         "100",
+			weights:       []string{},
+			caps:          []string{},
+			wantAllocated: []string{},
+			wantRemainder: "100",
+		},
+		{
+			name:      "length mismatch",
+			total:     "100",
+			weights:   []string{"1", "1"},
+			caps:      []string{"100"},
+			wantErr:   true,
+			errorType: finerrors.ErrInvalidArgument,
+		},
+		{
+			name:      "negative total",
+			total:     "-100",
+			weights:   []string{"1"},
+			caps:      []string{"100"},
+			wantErr:   true,
+			errorType: finerrors.ErrNegativeValue,
+		},
+		{
+			name:      "negative weight",
+			total:     "100",
+			weights:   []string{"-1"},
+			caps:      []string{"100"},
+			wantErr:   true,
+			errorType: finerrors.ErrNegativeValue,
+		},
+		{
+			name:      "negative cap",
+			total:     "100",
+			weights:   []string{"1"},
+			caps:      []string{"-100"},
+			wantErr:   true,
+			errorType: finerrors.ErrNegativeValue,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			total, _ := NewFromString(tt.total)
+			weights := make([]Decimal, len(tt.weights))
+			for i, w := range tt.weights {
+				weights[i], _ = NewFromString(w)
+			}
+			caps := make([]Decimal, len(tt.caps))
+			for i, c := range tt.caps {
+				caps[i], _ = NewFromString(c)
+			}
+
+			allocated, remainder, err := Apportion(total, weights, caps)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Apportion() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				if !errors.Is(err, tt.errorType) {
+					t.Errorf("Apportion() error = %v, want error type %v", err, tt.errorType)
+				}
+				return
+			}
+
+			if len(allocated) != len(tt.wantAllocated) {
+				t.Fatalf("Apportion() returned %d allocations, want %d", len(allocated), len(tt.wantAllocated))
+			}
+			sum := remainder
+			for i, a := range allocated {
+				if a.String() != tt.wantAllocated[i] {
+					t.Errorf("Apportion()[%d] = %v, want %v", i, a.String(), tt.wantAllocated[i])
+				}
+				sum = sum.Add(a)
+			}
+			if remainder.String() != tt.wantRemainder {
+				t.Errorf("Apportion() remainder = %v, want %v", remainder.String(), tt.wantRemainder)
+			}
+			if !sum.Equal(total) {
+				t.Errorf("Apportion() allocations + remainder = %v, want %v", sum, total)
+			}
+		})
+	}
+}
+
+func TestDecimal_Allocate(t *testing.T) {
+	tests := []struct {
+		name      string
+		amount    string
+		ratios    []int
+		places    int32
+		want      []string
+		wantErr   bool
+		errorType error
+	}{
+		{
+			name:   "ten dollars three ways",
+			amount: "10.00",
+			ratios: []int{1, 1, 1},
+			places: 2,
+			want:   []string{"3.34", "3.33", "3.33"},
+		},
+		{
+			name:   "weighted ratios",
+			amount: "100.00",
+			ratios: []int{70, 20, 10},
+			places: 2,
+			want:   []string{"70", "20", "10"},
+		},
+		{
+			name:   "weighted ratios with leftover",
+			amount: "0.05",
+			ratios: []int{3, 7},
+			places: 2,
+			want:   []string{"0.02", "0.03"},
+		},
+		{
+			name:   "zero ratio bucket skipped for leftover",
+			amount: "1.00",
+			ratios: []int{0, 1, 1, 1},
+			places: 2,
+			want:   []string{"0", "0.34", "0.33", "0.33"},
+		},
+		{
+			name:   "whole units",
+			amount: "100",
+			ratios: []int{1, 2},
+			places: 0,
+			want:   []string{"34", "66"},
+		},
+		{
+			name:   "zero amount",
+			amount: "0",
+			ratios: []int{1, 1},
+			places: 2,
+			want:   []string{"0", "0"},
+		},
+		{
+			name:      "empty ratios",
+			amount:    "10.00",
+			ratios:    []int{},
+			places:    2,
+			wantErr:   true,
+			errorType: finerrors.ErrEmptyInput,
+		},
+		{
+			name:      "all zero ratios",
+			amount:    "10.00",
+			ratios:    []int{0, 0},
+			places:    2,
+			wantErr:   true,
+			errorType: finerrors.ErrInvalidArgument,
+		},
+		{
+			name:      "negative ratio",
+			amount:    "10.00",
+			ratios:    []int{2, -1},
+			places:    2,
+			wantErr:   true,
+			errorType: finerrors.ErrInvalidArgument,
+		},
+		{
+			name:      "negative amount",
+			amount:    "-10.00",
+			ratios:    []int{1, 1},
+			places:    2,
+			wantErr:   true,
+			errorType: finerrors.ErrNegativeValue,
+		},
+		{
+			name:      "amount finer than precision",
+			amount:    "10.005",
+			ratios:    []int{1, 1},
+			places:    2,
+			wantErr:   true,
+			errorType: finerrors.ErrInvalidPrecision,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			amount, _ := NewFromString(tt.amount)
+
+			got, err := amount.Allocate(tt.ratios, tt.places)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Allocate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				if !errors.Is(err, tt.errorType) {
+					t.Errorf("Allocate() error = %v, want error type %v", err, tt.errorType)
+				}
+				return
+			}
+
+			if len(got) != len(tt.want) {
+				t.Fatalf("Allocate() returned %d parts, want %d", len(got), len(tt.want))
+			}
+			sum := Zero()
+			for i, part := range got {
+				if part.String() != tt.want[i] {
+					t.Errorf("Allocate()[%d] = %v, want %v", i, part.String(), tt.want[i])
+				}
+				sum = sum.Add(part)
+			}
+			if !sum.Equal(amount) {
+				t.Errorf("Allocate() parts sum to %v, want %v", sum, amount)
+			}
+		})
+	}
+}
+
+func TestDecimal_Split(t *testing.T) {
+	tests := []struct {
+		name    string
+		amount  string
+		n       int
+		places  int32
+		want    []string
+		wantErr bool
+	}{
+		{
+			name:   "ten dollars three ways",
+			amount: "10.00",
+			n:      3,
+			places: 2,
+			want:   []string{"3.34", "3.33", "3.33"},
+		},
+		{
+			name:   "even split",
+			amount: "9.00",
+			n:      3,
+			places: 2,
+			want:   []string{"3", "3", "3"},
+		},
+		{
+			name:   "single part",
+			amount: "9.99",
+			n:      1,
+			places: 2,
+			want:   []string{"9.99"},
+		},
+		{
+			name:    "zero parts",
+			amount:  "10.00",
+			n:       0,
+			places:  2,
+			wantErr: true,
+		},
+		{
+			name:    "negative parts",
+			amount:  "10.00",
+			n:       -1,
+			places:  2,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			amount, _ := NewFromString(tt.amount)
+
+			got, err := amount.Split(tt.n, tt.places)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Split() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				if !errors.Is(err, finerrors.ErrInvalidArgument) {
+					t.Errorf("Split() error is not ErrInvalidArgument: %v", err)
+				}
+				return
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("Split() returned %d parts, want %d", len(got), len(tt.want))
+			}
+			for i, part := range got {
+				if part.String() != tt.want[i] {
+					t.Errorf("Split()[%d] = %v, want %v", i, part.String(), tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestDecimal_SplitWhole(t *testing.T) {
+	tests := []struct {
+		name          string
+		amount        string
+		n             int
+		wantPart      string
+		wantRemainder string
+		wantErr       bool
+		errorType     error
+	}{
+		{
+			name:          "ten dollars three ways",
+			amount:        "10.00",
+			n:             3,
+			wantPart:      "3",
+			wantRemainder: "1",
+		},
+		{
+			name:          "fractional remainder",
+			amount:        "10.75",
+			n:             2,
+			wantPart:      "5",
+			wantRemainder: "0.75",
+		},
+		{
+			name:          "amount smaller than parts",
+			amount:        "2.50",
+			n:             3,
+			wantPart:      "0",
+			wantRemainder: "2.5",
+		},
+		{
+			name:          "even split",
+			amount:        "9",
+			n:             3,
+			wantPart:      "3",
+			wantRemainder: "0",
+		},
+		{
+			name:      "zero parts",
+			amount:    "10.00",
+			n:         0,
+			wantErr:   true,
+			errorType: finerrors.ErrInvalidArgument,
+		},
+		{
+			name:      "negative amount",
+			amount:    "-10.00",
+			n:         3,
+			wantErr:   true,
+			errorType: finerrors.ErrNegativeValue,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			amount, _ := NewFromString(tt.amount)
+
+			parts, remainder, err := amount.SplitWhole(tt.n)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SplitWhole() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				if !errors.Is(err, tt.errorType) {
+					t.Errorf("SplitWhole() error = %v, want error type %v", err, tt.errorType)
+				}
+				return
+			}
+
+			if len(parts) != tt.n {
+				t.Fatalf("SplitWhole() returned %d parts, want %d", len(parts), tt.n)
+			}
+			sum := remainder
+			for i, part := range parts {
+				if part.String() != tt.wantPart {
+					t.Errorf("SplitWhole()[%d] = %v, want %v", i, part.String(), tt.wantPart)
+				}
+				sum = sum.Add(part)
+			}
+			if remainder.String() != tt.wantRemainder {
+				t.Errorf("SplitWhole() remainder = %v, want %v", remainder.String(), tt.wantRemainder)
+			}
+			if !sum.Equal(amount) {
+				t.Errorf("SplitWhole() parts and remainder sum to %v, want %v", sum, amount)
+			}
+		})
+	}
+}
+
+func TestAllocate(t *testing.T) {
+	tests := []struct {
+		name      string
+		total     string
+		ratios    []string
+		want      []string
+		wantErr   bool
+		errorType error
+	}{
+		{
+			name:   "equal ratios",
+			total:  "100.00",
+			ratios: []string{"1", "1", "1"},
+			want:   []string{"33.34", "33.33", "33.33"},
+		},
+		{
+			name:   "leftover to largest remainders",
+			total:  "0.10",
+			ratios: []string{"1", "2", "4"},
+			want:   []string{"0.01", "0.03", "0.06"},
+		},
+		{
+			name:   "fractional ratios",
+			total:  "100",
+			ratios: []string{"0.25", "0.75"},
+			want:   []string{"25", "75"},
+		},
+		{
+			name:   "whole units",
+			total:  "100",
+			ratios: []string{"1", "2"},
+			want:   []string{"33", "67"},
+		},
+		{
+			name:   "zero ratio bucket",
+			total:  "1.00",
+			ratios: []string{"0", "1", "1", "1"},
+			want:   []string{"0", "0.34", "0.33", "0.33"},
+		},
+		{
+			name:   "negative total",
+			total:  "-0.10",
+			ratios: []string{"1", "2", "4"},
+			want:   []string{"-0.01", "-0.03", "-0.06"},
+		},
+		{
+			name:      "empty ratios",
+			total:     "10.00",
+			ratios:    []string{},
+			wantErr:   true,
+			errorType: finerrors.ErrEmptyInput,
+		},
+		{
+			name:      "negative ratio",
+			total:     "10.00",
+			ratios:    []string{"2", "-1"},
+			wantErr:   true,
+			errorType: finerrors.ErrNegativeValue,
+		},
+		{
+			name:      "ratios sum to zero",
+			total:     "10.00",
+			ratios:    []string{"0", "0.00"},
+			wantErr:   true,
+			errorType: finerrors.ErrInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			total, _ := NewFromString(tt.total)
+			ratios := make([]Decimal, len(tt.ratios))
+			for i, r := range tt.ratios {
+				ratios[i], _ = NewFromString(r)
+			}
+
+			got, err := Allocate(total, ratios)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Allocate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				if !errors.Is(err, tt.errorType) {
+					t.Errorf("Allocate() error = %v, want error type %v", err, tt.errorType)
+				}
+				return
+			}
+
+			if len(got) != len(tt.want) {
+				t.Fatalf("Allocate() returned %d parts, want %d", len(got), len(tt.want))
+			}
+			sum := Zero()
+			for i, part := range got {
+				if part.String() != tt.want[i] {
+					t.Errorf("Allocate()[%d] = %v, want %v", i, part.String(), tt.want[i])
+				}
+				sum = sum.Add(part)
+			}
+			if !sum.Equal(total) {
+				t.Errorf("Allocate() parts sum to %v, want %v", sum, total)
+			}
+		})
+	}
+}
+
+func TestAllocateEqual(t *testing.T) {
+	tests := []struct {
+		name    string
+		total   string
+		n       int
+		want    []string
+		wantErr bool
+	}{
+		{
+			name:  "ten dollars three ways",
+			total: "10.00",
+			n:     3,
+			want:  []string{"3.34", "3.33", "3.33"},
+		},
+		{
+			name:  "divides evenly",
+			total: "9.00",
+			n:     3,
+			want:  []string{"3", "3", "3"},
+		},
+		{
+			name:  "single bucket",
+			total: "10.01",
+			n:     1,
+			want:  []string{"10.01"},
+		},
+		{
+			name:    "zero buckets",
+			total:   "10.00",
+			n:       0,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			total, _ := NewFromString(tt.total)
+
+			got, err := AllocateEqual(total, tt.n)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("AllocateEqual() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				if !errors.Is(err, finerrors.ErrInvalidArgument) {
+					t.Errorf("AllocateEqual() error = %v, want error type %v", err, finerrors.ErrInvalidArgument)
+				}
+				return
+			}
+
+			if len(got) != len(tt.want) {
+				t.Fatalf("AllocateEqual() returned %d parts, want %d", len(got), len(tt.want))
+			}
+			for i, part := range got {
+				if part.String() != tt.want[i] {
+					t.Errorf("AllocateEqual()[%d] = %v, want %v", i, part.String(), tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestSplitEqual(t *testing.T) {
+	tests := []struct {
+		name          string
+		total         string
+		n             int
+		mode          rounding.Mode
+		wantShare     string
+		wantRemainder string
+		wantErr       bool
+		errorType     error
+	}{
+		{
+			name:          "remainder left over",
+			total:         "100.00",
+			n:             3,
+			mode:          rounding.RoundDown,
+			wantShare:     "33.33",
+			wantRemainder: "0.01",
+		},
+		{
+			name:          "rounding up overshoots",
+			total:         "100.00",
+			n:             3,
+			mode:          rounding.RoundUp,
+			wantShare:     "33.34",
+			wantRemainder: "-0.02",
+		},
+		{
+			name:          "half up",
+			total:         "10.00",
+			n:             6,
+			mode:          rounding.RoundHalfUp,
+			wantShare:     "1.67",
+			wantRemainder: "-0.02",
+		},
+		{
+			name:          "divides evenly",
+			total:         "90.00",
+			n:             3,
+			mode:          rounding.RoundHalfEven,
+			wantShare:     "30",
+			wantRemainder: "0",
+		},
+		{
+			name:          "whole units",
+			total:         "100",
+			n:             7,
+			mode:          rounding.RoundDown,
+			wantShare:     "14",
+			wantRemainder: "2",
+		},
+		{
+			name:          "negative total",
+			total:         "-100.00",
+			n:             3,
+			mode:          rounding.RoundDown,
+			wantShare:     "-33.33",
+			wantRemainder: "-0.01",
+		},
+		{
+			name:      "zero shares",
+			total:     "100.00",
+			n:         0,
+			mode:      rounding.RoundDown,
+			wantErr:   true,
+			errorType: finerrors.ErrInvalidArgument,
+		},
+		{
+			name:      "negative shares",
+			total:     "100.00",
+			n:         -2,
+			mode:      rounding.RoundDown,
+			wantErr:   true,
+			errorType: finerrors.ErrInvalidArgument,
+		},
+		{
+			name:      "invalid rounding mode",
+			total:     "100.00",
+			n:         3,
+			mode:      rounding.Mode(99),
+			wantErr:   true,
+			errorType: finerrors.ErrInvalidRounding,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			total, _ := NewFromString(tt.total)
+
+			shares, remainder, err := SplitEqual(total, tt.n, tt.mode)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SplitEqual() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				if !errors.Is(err, tt.errorType) {
+					t.Errorf("SplitEqual() error = %v, want error type %v", err, tt.errorType)
+				}
+				return
+			}
+
+			if len(shares) != tt.n {
+				t.Fatalf("SplitEqual() returned %d shares, want %d", len(shares), tt.n)
+			}
+			sum := remainder
+			for i, share := range shares {
+				if share.String() != tt.wantShare {
+					t.Errorf("SplitEqual()[%d] = %v, want %v", i, share.String(), tt.wantShare)
+				}
+				sum = sum.Add(share)
+			}
+			if remainder.String() != tt.wantRemainder {
+				t.Errorf("SplitEqual() remainder = %v, want %v", remainder.String(), tt.wantRemainder)
+			}
+			if !sum.Equal(total) {
+				t.Errorf("SplitEqual() shares and remainder sum to %v, want %v", sum, total)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package safedec
+
+import (
+	"database/sql/driver"
+	"fmt"
+
+	"github.com/nduyhai/finarith/errors"
+)
+
+// Scan implements sql.Scanner so that a Decimal can be read from a NUMERIC column.
+// It accepts the string, []byte, int64 and float64 values produced by database drivers.
+// Returns an error wrapping ErrInvalidFormat, and the underlying parse error if any, if the
+// value is NULL, of an unsupported type, or cannot be parsed.
+//
+// Decimal cannot also implement driver.Valuer, since its Value method already returns the
+// underlying decimal.Decimal; pass d.SQL() as the query argument when writing.
+func (d *Decimal) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case string:
+		return d.scanString(v)
+	case []byte:
+		return d.scanString(string(v))
+	case int64:
+		*d = NewFromInt(v)
+		return nil
+	case float64:
+		*d = NewFromFloat(v)
+		return nil
+	case nil:
+		return fmt.Errorf("%w: cannot scan NULL into Decimal", errors.ErrInvalidFormat)
+	default:
+		return fmt.Errorf("%w: cannot scan %T into Decimal", errors.ErrInvalidFormat, src)
+	}
+}
+
+// scanString parses a scanned string value into d.
+func (d *Decimal) scanString(s string) error {
+	parsed, err := NewFromString(s)
+	if err != nil {
+		return fmt.Errorf("%w: cannot scan %q into Decimal: %w", errors.ErrInvalidFormat, s, err)
+	}
+	*d = parsed
+	return nil
+}
+
+// SQLDecimal is a Decimal for use as a database/sql query argument or scan destination.
+// It implements both driver.Valuer and sql.Scanner, writing the string representation so that
+// NUMERIC columns receive the exact value.
+type SQLDecimal Decimal
+
+// SQL returns the decimal as an SQLDecimal, so that it can be passed to db.Exec and similar
+// methods, which reject a plain Decimal.
+func (d Decimal) SQL() SQLDecimal {
+	return SQLDecimal(d)
+}
+
+// Decimal returns the SQLDecimal as a Decimal.
+func (s SQLDecimal) Decimal() Decimal {
+	return Decimal(s)
+}
+
+// Value implements driver.Valuer, returning the string representation of the decimal.
+func (s SQLDecimal) Value() (driver.Value, error) {
+	return Decimal(s).String(), nil
+}
+
+// Scan implements sql.Scanner with the same rules as Decimal.Scan.
+func (s *SQLDecimal) Scan(src interface{}) error {
+	return (*Decimal)(s).Scan(src)
+}
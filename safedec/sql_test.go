@@ -0,0 +1,183 @@
+package safedec
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"testing"
+
+	finerrors "github.com/nduyhai/finarith/errors"
+)
+
+var _ sql.Scanner = (*Decimal)(nil)
+
+var (
+	_ driver.Valuer = SQLDecimal{}
+	_ sql.Scanner   = (*SQLDecimal)(nil)
+)
+
+func TestDecimal_Scan(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     interface{}
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "string",
+			src:  "123.45",
+			want: "123.45",
+		},
+		{
+			name: "bytes",
+			src:  []byte("-0.0001"),
+			want: "-0.0001",
+		},
+		{
+			name: "int64",
+			src:  int64(42),
+			want: "42",
+		},
+		{
+			name: "float64",
+			src:  float64(1.25),
+			want: "1.25",
+		},
+		{
+			name:    "unparsable string",
+			src:     "12abc",
+			wantErr: true,
+		},
+		{
+			name:    "null",
+			src:     nil,
+			wantErr: true,
+		},
+		{
+			name:    "unsupported type",
+			src:     true,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var d Decimal
+			err := d.Scan(tt.src)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Scan() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				if !errors.Is(err, finerrors.ErrInvalidFormat) {
+					t.Errorf("Scan() error is not ErrInvalidFormat: %v", err)
+				}
+				return
+			}
+			if d.String() != tt.want {
+				t.Errorf("Scan() = %v, want %v", d.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimal_ScanWrapsParseError(t *testing.T) {
+	var d Decimal
+	err := d.Scan("12abc")
+	_, parseErr := NewFromString("12abc")
+
+	wrapped, ok := err.(interface{ Unwrap() []error })
+	if !ok {
+		t.Fatalf("Scan() error = %v, want an error wrapping the parse failure", err)
+	}
+	found := false
+	for _, e := range wrapped.Unwrap() {
+		if e.Error() == parseErr.Error() {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("Scan() error = %v, want it to wrap %v", err, parseErr)
+	}
+}
+
+func TestDecimal_ValueRoundTrip(t *testing.T) {
+	d, _ := NewFromString("98765.4321")
+
+	valuer, ok := interface{}(d.Value()).(driver.Valuer)
+	if !ok {
+		t.Fatalf("Value() does not implement driver.Valuer")
+	}
+	v, err := valuer.Value()
+	if err != nil {
+		t.Fatalf("Value().Value() error = %v", err)
+	}
+	if v != "98765.4321" {
+		t.Errorf("Value().Value() = %v, want 98765.4321", v)
+	}
+
+	var scanned Decimal
+	if err := scanned.Scan(v); err != nil {
+		t.Fatalf("Scan() error = %v", err)
+	}
+	if !scanned.Equal(d) {
+		t.Errorf("Scan() = %v, want %v", scanned, d)
+	}
+}
+
+func TestSQLDecimal_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  string
+	}{
+		{
+			name:  "fractional",
+			value: "98765.4321",
+			want:  "98765.4321",
+		},
+		{
+			name:  "negative",
+			value: "-0.01",
+			want:  "-0.01",
+		},
+		{
+			name:  "beyond float64 precision",
+			value: "12345678901234567890.123456789",
+			want:  "12345678901234567890.123456789",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, _ := NewFromString(tt.value)
+
+			// database/sql applies the default converter to query arguments
+			v, err := driver.DefaultParameterConverter.ConvertValue(d.SQL())
+			if err != nil {
+				t.Fatalf("ConvertValue() error = %v", err)
+			}
+			if v != tt.want {
+				t.Errorf("ConvertValue() = %v, want %v", v, tt.want)
+			}
+
+			var scanned SQLDecimal
+			if err := scanned.Scan(v); err != nil {
+				t.Fatalf("Scan() error = %v", err)
+			}
+			if !scanned.Decimal().Equal(d) {
+				t.Errorf("Scan() = %v, want %v", scanned.Decimal(), d)
+			}
+		})
+	}
+}
+
+func TestSQLDecimal_ScanInvalid(t *testing.T) {
+	var s SQLDecimal
+	if err := s.Scan("12.3.4"); !errors.Is(err, finerrors.ErrInvalidFormat) {
+		t.Errorf("Scan() error = %v, want %v", err, finerrors.ErrInvalidFormat)
+	}
+	if err := s.Scan(nil); !errors.Is(err, finerrors.ErrInvalidFormat) {
+		t.Errorf("Scan(nil) error = %v, want %v", err, finerrors.ErrInvalidFormat)
+	}
+}
@@ -0,0 +1,47 @@
+package safeint
+
+import (
+	"github.com/nduyhai/finarith/rounding"
+)
+
+// Accumulator accumulates int64 amounts, such as integer cents, with overflow detection.
+// The zero value is an empty accumulator ready for use.
+type Accumulator struct {
+	total int64
+}
+
+// Add adds v to the accumulated value.
+// Returns an error if the addition would overflow, in which case the accumulated value is unchanged.
+func (a *Accumulator) Add(v int64) error {
+	total, err := Add(a.total, v)
+	if err != nil {
+		return err
+	}
+	a.total = total
+	return nil
+}
+
+// Value returns the accumulated value.
+func (a *Accumulator) Value() int64 {
+	return a.total
+}
+
+// SettleTo rounds the accumulated value to a multiple of unit using the specified rounding mode
+// and returns it as settled. The remainder is returned as carry and kept as the accumulated value
+// for the next period, so that settled + carry always equals the value before settling.
+// Returns an error if unit is not positive, if the rounding mode is invalid, or if the carry
+// cannot be represented, in which case the accumulated value is unchanged.
+func (a *Accumulator) SettleTo(unit int64, mode rounding.Mode) (settled, carry int64, err error) {
+	settled, err = rounding.RoundInt64(a.total, unit, mode)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	carry, err = Sub(a.total, settled)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	a.total = carry
+	return settled, carry, nil
+}
@@ -0,0 +1,166 @@
+package safeint
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	finerrors "github.com/nduyhai/finarith/errors"
+	"github.com/nduyhai/finarith/rounding"
+)
+
+func TestAccumulator_Add(t *testing.T) {
+	tests := []struct {
+		name    string
+		values  []int64
+		want    int64
+		wantErr bool
+	}{
+		{
+			name:   "empty",
+			values: nil,
+			want:   0,
+		},
+		{
+			name:   "mixed signs",
+			values: []int64{1250, -300, 75},
+			want:   1025,
+		},
+		{
+			name:    "overflow keeps previous value",
+			values:  []int64{math.MaxInt64 - 1, 2},
+			want:    math.MaxInt64 - 1,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var a Accumulator
+			var err error
+			for _, v := range tt.values {
+				if err = a.Add(v); err != nil {
+					break
+				}
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Add() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, finerrors.ErrOverflow) {
+				t.Errorf("Add() error is not ErrOverflow: %v", err)
+			}
+			if got := a.Value(); got != tt.want {
+				t.Errorf("Value() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAccumulator_SettleTo(t *testing.T) {
+	type period struct {
+		cents       []int64
+		wantSettled int64
+		wantCarry   int64
+	}
+
+	tests := []struct {
+		name    string
+		unit    int64
+		mode    rounding.Mode
+		periods []period
+	}{
+		{
+			name: "cents to dollars half up",
+			unit: 100,
+			mode: rounding.RoundHalfUp,
+			periods: []period{
+				{cents: []int64{1234, 5678}, wantSettled: 6900, wantCarry: 12},
+				{cents: []int64{4550}, wantSettled: 4600, wantCarry: -38},
+				{cents: []int64{38}, wantSettled: 0, wantCarry: 0},
+			},
+		},
+		{
+			name: "cents to dollars round down",
+			unit: 100,
+			mode: rounding.RoundDown,
+			periods: []period{
+				{cents: []int64{199, 250}, wantSettled: 400, wantCarry: 49},
+				{cents: []int64{51}, wantSettled: 100, wantCarry: 0},
+			},
+		},
+		{
+			name: "negative balance half even",
+			unit: 100,
+			mode: rounding.RoundHalfEven,
+			periods: []period{
+				{cents: []int64{-250}, wantSettled: -200, wantCarry: -50},
+				{cents: []int64{-100}, wantSettled: -200, wantCarry: 50},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var a Accumulator
+			for i, p := range tt.periods {
+				for _, c := range p.cents {
+					if err := a.Add(c); err != nil {
+						t.Fatalf("Add() error = %v", err)
+					}
+				}
+				before := a.Value()
+
+				settled, carry, err := a.SettleTo(tt.unit, tt.mode)
+				if err != nil {
+					t.Fatalf("period %d: SettleTo() error = %v", i, err)
+				}
+				if settled != p.wantSettled || carry != p.wantCarry {
+					t.Errorf("period %d: SettleTo() = (%v, %v), want (%v, %v)", i, settled, carry, p.wantSettled, p.wantCarry)
+				}
+				if settled+carry != before {
+					t.Errorf("period %d: settled + carry = %v, want %v", i, settled+carry, before)
+				}
+				if a.Value() != carry {
+					t.Errorf("period %d: Value() after SettleTo() = %v, want %v", i, a.Value(), carry)
+				}
+			}
+		})
+	}
+}
+
+func TestAccumulator_SettleToErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		unit      int64
+		mode      rounding.Mode
+		errorType error
+	}{
+		{
+			name:      "zero unit",
+			unit:      0,
+			mode:      rounding.RoundHalfUp,
+			errorType: finerrors.ErrInvalidPrecision,
+		},
+		{
+			name:      "invalid rounding mode",
+			unit:      100,
+			mode:      rounding.Mode(99),
+			errorType: finerrors.ErrInvalidRounding,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var a Accumulator
+			_ = a.Add(1234)
+
+			_, _, err := a.SettleTo(tt.unit, tt.mode)
+			if !errors.Is(err, tt.errorType) {
+				t.Errorf("SettleTo() error = %v, want error type %v", err, tt.errorType)
+			}
+			if a.Value() != 1234 {
+				t.Errorf("Value() after failed SettleTo() = %v, want 1234", a.Value())
+			}
+		})
+	}
+}
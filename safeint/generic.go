@@ -0,0 +1,52 @@
+package safeint
+
+import (
+	"github.com/nduyhai/finarith/errors"
+)
+
+// Signed is a constraint that permits any signed integer type.
+// It matches golang.org/x/exp/constraints.Signed without adding a dependency.
+type Signed interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64
+}
+
+// AddOf performs the addition of two signed integers of any width with overflow checking.
+// Overflow is detected relative to the range of T, e.g. 127 + 1 overflows for int8.
+// Returns an error if the operation results in an overflow.
+func AddOf[T Signed](a, b T) (T, error) {
+	// Signed addition wraps around in Go, so a result that moved the wrong way overflowed
+	result := a + b
+	if (b > 0 && result < a) || (b < 0 && result > a) {
+		return 0, errors.NewOverflowError("+", a, b)
+	}
+	return result, nil
+}
+
+// SubOf performs subtraction of two signed integers of any width with overflow checking.
+// Overflow is detected relative to the range of T, e.g. -128 - 1 overflows for int8.
+// Returns an error if the operation results in an overflow.
+func SubOf[T Signed](a, b T) (T, error) {
+	result := a - b
+	if (b > 0 && result > a) || (b < 0 && result < a) {
+		return 0, errors.NewOverflowError("-", a, b)
+	}
+	return result, nil
+}
+
+// MulOf performs multiplication of two signed integers of any width with overflow checking.
+// Overflow is detected relative to the range of T, e.g. 64 * 2 overflows for int8.
+// Returns an error if the operation results in an overflow.
+func MulOf[T Signed](a, b T) (T, error) {
+	if a == 0 || b == 0 {
+		return 0, nil
+	}
+
+	// A wrapped product either fails to divide back to a or has the wrong sign; the sign check
+	// catches the minimum value times -1, which divides back to itself
+	result := a * b
+	sameSign := (a < 0) == (b < 0)
+	if result/b != a || (sameSign && result < 0) || (!sameSign && result > 0) {
+		return 0, errors.NewOverflowError("*", a, b)
+	}
+	return result, nil
+}
@@ -0,0 +1,266 @@
+package safeint
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	finerrors "github.com/nduyhai/finarith/errors"
+)
+
+func TestAddOf_Int8(t *testing.T) {
+	tests := []struct {
+		name    string
+		a       int8
+		b       int8
+		want    int8
+		wantErr bool
+	}{
+		{
+			name: "simple addition",
+			a:    100,
+			b:    27,
+			want: 127,
+		},
+		{
+			name:    "wraparound at 127",
+			a:       127,
+			b:       1,
+			wantErr: true,
+		},
+		{
+			name:    "wraparound at -128",
+			a:       -128,
+			b:       -1,
+			wantErr: true,
+		},
+		{
+			name: "mixed signs at bounds",
+			a:    127,
+			b:    -128,
+			want: -1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := AddOf(tt.a, tt.b)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("AddOf() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("AddOf() = %v, want %v", got, tt.want)
+			}
+			if err != nil && !errors.Is(err, finerrors.ErrOverflow) {
+				t.Errorf("AddOf() error is not ErrOverflow: %v", err)
+			}
+		})
+	}
+}
+
+func TestSubOf_Int8(t *testing.T) {
+	tests := []struct {
+		name    string
+		a       int8
+		b       int8
+		want    int8
+		wantErr bool
+	}{
+		{
+			name: "simple subtraction",
+			a:    -100,
+			b:    28,
+			want: -128,
+		},
+		{
+			name:    "wraparound at -128",
+			a:       -128,
+			b:       1,
+			wantErr: true,
+		},
+		{
+			name:    "wraparound at 127",
+			a:       0,
+			b:       -128,
+			wantErr: true,
+		},
+		{
+			name: "minus one minus min",
+			a:    -1,
+			b:    -128,
+			want: 127,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SubOf(tt.a, tt.b)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SubOf() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("SubOf() = %v, want %v", got, tt.want)
+			}
+			if err != nil && !errors.Is(err, finerrors.ErrOverflow) {
+				t.Errorf("SubOf() error is not ErrOverflow: %v", err)
+			}
+		})
+	}
+}
+
+func TestMulOf_Int8(t *testing.T) {
+	tests := []struct {
+		name    string
+		a       int8
+		b       int8
+		want    int8
+		wantErr bool
+	}{
+		{
+			name: "simple multiplication",
+			a:    -16,
+			b:    8,
+			want: -128,
+		},
+		{
+			name:    "positive overflow",
+			a:       64,
+			b:       2,
+			wantErr: true,
+		},
+		{
+			name:    "product wraps to zero",
+			a:       16,
+			b:       16,
+			wantErr: true,
+		},
+		{
+			name:    "min times minus one",
+			a:       -128,
+			b:       -1,
+			wantErr: true,
+		},
+		{
+			name:    "minus one times min",
+			a:       -1,
+			b:       -128,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MulOf(tt.a, tt.b)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MulOf() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("MulOf() = %v, want %v", got, tt.want)
+			}
+			if err != nil && !errors.Is(err, finerrors.ErrOverflow) {
+				t.Errorf("MulOf() error is not ErrOverflow: %v", err)
+			}
+		})
+	}
+}
+
+func TestOf_Int32Boundaries(t *testing.T) {
+	tests := []struct {
+		name    string
+		op      func(a, b int32) (int32, error)
+		a       int32
+		b       int32
+		want    int32
+		wantErr bool
+	}{
+		{
+			name: "add up to max",
+			op:   AddOf[int32],
+			a:    math.MaxInt32 - 1,
+			b:    1,
+			want: math.MaxInt32,
+		},
+		{
+			name:    "add past max",
+			op:      AddOf[int32],
+			a:       math.MaxInt32,
+			b:       1,
+			wantErr: true,
+		},
+		{
+			name:    "sub past min",
+			op:      SubOf[int32],
+			a:       math.MinInt32,
+			b:       1,
+			wantErr: true,
+		},
+		{
+			name: "mul within range",
+			op:   MulOf[int32],
+			a:    46340,
+			b:    46340,
+			want: 2147395600,
+		},
+		{
+			name:    "mul past max",
+			op:      MulOf[int32],
+			a:       46341,
+			b:       46341,
+			wantErr: true,
+		},
+		{
+			name:    "mul min by minus one",
+			op:      MulOf[int32],
+			a:       math.MinInt32,
+			b:       -1,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.op(tt.a, tt.b)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("op() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("op() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOf_Int8Exhaustive(t *testing.T) {
+	ops := []struct {
+		name  string
+		op    func(a, b int8) (int8, error)
+		exact func(a, b int) int
+	}{
+		{"AddOf", AddOf[int8], func(a, b int) int { return a + b }},
+		{"SubOf", SubOf[int8], func(a, b int) int { return a - b }},
+		{"MulOf", MulOf[int8], func(a, b int) int { return a * b }},
+	}
+
+	for _, o := range ops {
+		t.Run(o.name, func(t *testing.T) {
+			for a := math.MinInt8; a <= math.MaxInt8; a++ {
+				for b := math.MinInt8; b <= math.MaxInt8; b++ {
+					exact := o.exact(a, b)
+					wantErr := exact < math.MinInt8 || exact > math.MaxInt8
+
+					got, err := o.op(int8(a), int8(b))
+					if (err != nil) != wantErr {
+						t.Fatalf("%s(%d, %d) error = %v, wantErr %v", o.name, a, b, err, wantErr)
+					}
+					if !wantErr && int(got) != exact {
+						t.Fatalf("%s(%d, %d) = %v, want %v", o.name, a, b, got, exact)
+					}
+				}
+			}
+		})
+	}
+}
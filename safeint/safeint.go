@@ -2,7 +2,9 @@
 package safeint
 
 import (
+	"fmt"
 	"math"
+	"math/big"
 
 	"github.com/nduyhai/finarith/errors"
 )
@@ -10,67 +12,63 @@ import (
 // Add performs the addition of two int64 values with overflow checking.
 // Returns an error if the operation results in an overflow.
 func Add(a, b int64) (int64, error) {
-	// Check for positive overflow: a + b > MaxInt64
-	if b > 0 && a > math.MaxInt64-b {
-		return 0, errors.NewOverflowError("+", a, b)
-	}
-
-	// Check for negative overflow: a + b < MinInt64
-	if b < 0 && a < math.MinInt64-b {
-		return 0, errors.NewOverflowError("+", a, b)
-	}
-
-	return a + b, nil
+	return AddOf(a, b)
 }
 
 // Sub performs subtraction of two int64 values with overflow checking.
 // Returns an error if the operation results in an overflow.
 func Sub(a, b int64) (int64, error) {
-	// Check for positive overflow: a - b > MaxInt64, which can happen when b is very negative
-	if b < 0 && a > math.MaxInt64+b {
-		return 0, errors.NewOverflowError("-", a, b)
+	return SubOf(a, b)
+}
+
+// Mul performs multiplication of two int64 values with overflow checking.
+// Returns an error if the operation results in an overflow.
+func Mul(a, b int64) (int64, error) {
+	return MulOf(a, b)
+}
+
+// Div performs division of two int64 values, truncating toward zero, with overflow checking.
+// Returns an error if b is zero or if the operation results in an overflow, which only happens
+// for MinInt64 / -1.
+func Div(a, b int64) (int64, error) {
+	if b == 0 {
+		return 0, errors.ErrDivideByZero
 	}
 
-	// Check for negative overflow: a - b < MinInt64, which can happen when b is very positive
-	if b > 0 && a < math.MinInt64+b {
-		return 0, errors.NewOverflowError("-", a, b)
+	// The magnitude of MinInt64 is one more than MaxInt64
+	if a == math.MinInt64 && b == -1 {
+		return 0, errors.NewOverflowError("/", a, b)
 	}
 
-	return a - b, nil
+	return a / b, nil
 }
 
-// Mul performs multiplication of two int64 values with overflow checking.
-// Returns an error if the operation results in an overflow.
-func Mul(a, b int64) (int64, error) {
-	// Special cases to avoid division by zero in the overflow checks
-	if a == 0 || b == 0 {
-		return 0, nil
+// Mod returns the remainder of the truncated division of two int64 values, which takes the sign of a.
+// Returns an error if b is zero.
+func Mod(a, b int64) (int64, error) {
+	if b == 0 {
+		return 0, errors.ErrDivideByZero
 	}
 
-	// Check for overflow
-	if a > 0 && b > 0 {
-		// Both positive: check if a > MaxInt64/b
-		if a > math.MaxInt64/b {
-			return 0, errors.NewOverflowError("*", a, b)
-		}
-	} else if a < 0 && b < 0 {
-		// Both negative: check if a < MaxInt64/b (result will be positive)
-		if a < math.MaxInt64/b {
-			return 0, errors.NewOverflowError("*", a, b)
-		}
-	} else if a > 0 && b < 0 {
-		// a positive, b negative: check if b < MinInt64/a
-		if b < math.MinInt64/a {
-			return 0, errors.NewOverflowError("*", a, b)
-		}
-	} else if a < 0 && b > 0 {
-		// a negative, b positive: check if a < MinInt64/b
-		if a < math.MinInt64/b {
-			return 0, errors.NewOverflowError("*", a, b)
-		}
+	return a % b, nil
+}
+
+// Neg returns the negation of an int64 value with overflow checking.
+// Returns an error if the operation results in an overflow, which only happens for MinInt64.
+func Neg(a int64) (int64, error) {
+	if a == math.MinInt64 {
+		return 0, errors.NewOverflowError("-", 0, a)
 	}
+	return -a, nil
+}
 
-	return a * b, nil
+// Abs returns the absolute value of an int64 value with overflow checking.
+// Returns an error if the operation results in an overflow, which only happens for MinInt64.
+func Abs(a int64) (int64, error) {
+	if a < 0 {
+		return Neg(a)
+	}
+	return a, nil
 }
 
 // AddWithLimit performs addition with a maximum limit check.
@@ -117,3 +115,72 @@ func MulWithLimit(a, b, limit int64) (int64, error) {
 
 	return result, nil
 }
+
+// Clamp returns lower if v is less than lower, upper if v is greater than upper, and v otherwise.
+// Returns an error wrapping ErrInvalidRange if lower is greater than upper.
+func Clamp(v, lower, upper int64) (int64, error) {
+	if lower > upper {
+		return 0, fmt.Errorf("%w: lower bound %d is greater than upper bound %d", errors.ErrInvalidRange, lower, upper)
+	}
+
+	switch {
+	case v < lower:
+		return lower, nil
+	case v > upper:
+		return upper, nil
+	default:
+		return v, nil
+	}
+}
+
+// PrefixSums returns the running totals of values, where the i-th element is the sum of
+// values[0] through values[i], with overflow checking at each step.
+// Returns an error wrapping ErrOverflow that reports the index at which the running total
+// overflows.
+func PrefixSums(values []int64) ([]int64, error) {
+	sums := make([]int64, len(values))
+	var total int64
+	for i, v := range values {
+		next, err := Add(total, v)
+		if err != nil {
+			return nil, fmt.Errorf("%w: at index %d", err, i)
+		}
+		total = next
+		sums[i] = total
+	}
+
+	return sums, nil
+}
+
+// CompareRatios compares the ratios a/b and c/d without dividing, returning -1 if a/b < c/d,
+// 0 if they are equal, and +1 if a/b > c/d. The cross products are computed with overflow
+// checking and fall back to big.Int arithmetic when they do not fit in an int64.
+// Returns an error if either denominator is zero.
+func CompareRatios(a, b, c, d int64) (int, error) {
+	if b == 0 || d == 0 {
+		return 0, errors.ErrDivideByZero
+	}
+
+	// Dividing by a negative denominator flips the direction of the comparison
+	sign := 1
+	if (b < 0) != (d < 0) {
+		sign = -1
+	}
+
+	ad, errAD := Mul(a, d)
+	cb, errCB := Mul(c, b)
+	if errAD == nil && errCB == nil {
+		switch {
+		case ad < cb:
+			return -sign, nil
+		case ad > cb:
+			return sign, nil
+		default:
+			return 0, nil
+		}
+	}
+
+	left := new(big.Int).Mul(big.NewInt(a), big.NewInt(d))
+	right := new(big.Int).Mul(big.NewInt(c), big.NewInt(b))
+	return left.Cmp(right) * sign, nil
+}
@@ -3,6 +3,8 @@ package safeint
 import (
 	"errors"
 	"math"
+	"reflect"
+	"strings"
 	"testing"
 
 	finerrors "github.com/nduyhai/finarith/errors"
@@ -236,6 +238,256 @@ func TestMul(t *testing.T) {
 	}
 }
 
+func TestDiv(t *testing.T) {
+	tests := []struct {
+		name      string
+		a         int64
+		b         int64
+		want      int64
+		wantErr   bool
+		errorType error
+	}{
+		{
+			name:    "simple division",
+			a:       200,
+			b:       10,
+			want:    20,
+			wantErr: false,
+		},
+		{
+			name:    "truncates toward zero",
+			a:       7,
+			b:       2,
+			want:    3,
+			wantErr: false,
+		},
+		{
+			name:    "negative truncates toward zero",
+			a:       -7,
+			b:       2,
+			want:    -3,
+			wantErr: false,
+		},
+		{
+			name:    "min int64 by one",
+			a:       math.MinInt64,
+			b:       1,
+			want:    math.MinInt64,
+			wantErr: false,
+		},
+		{
+			name:    "max int64 by minus one",
+			a:       math.MaxInt64,
+			b:       -1,
+			want:    -math.MaxInt64,
+			wantErr: false,
+		},
+		{
+			name:      "min int64 by minus one",
+			a:         math.MinInt64,
+			b:         -1,
+			want:      0,
+			wantErr:   true,
+			errorType: finerrors.ErrOverflow,
+		},
+		{
+			name:      "divide by zero",
+			a:         10,
+			b:         0,
+			want:      0,
+			wantErr:   true,
+			errorType: finerrors.ErrDivideByZero,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Div(tt.a, tt.b)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Div() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Div() = %v, want %v", got, tt.want)
+			}
+			if err != nil && !errors.Is(err, tt.errorType) {
+				t.Errorf("Div() error = %v, want error type %v", err, tt.errorType)
+			}
+		})
+	}
+}
+
+func TestMod(t *testing.T) {
+	tests := []struct {
+		name    string
+		a       int64
+		b       int64
+		want    int64
+		wantErr bool
+	}{
+		{
+			name:    "simple remainder",
+			a:       7,
+			b:       3,
+			want:    1,
+			wantErr: false,
+		},
+		{
+			name:    "negative dividend",
+			a:       -7,
+			b:       3,
+			want:    -1,
+			wantErr: false,
+		},
+		{
+			name:    "negative divisor",
+			a:       7,
+			b:       -3,
+			want:    1,
+			wantErr: false,
+		},
+		{
+			name:    "min int64 by minus one",
+			a:       math.MinInt64,
+			b:       -1,
+			want:    0,
+			wantErr: false,
+		},
+		{
+			name:    "divide by zero",
+			a:       10,
+			b:       0,
+			want:    0,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Mod(tt.a, tt.b)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Mod() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Mod() = %v, want %v", got, tt.want)
+			}
+			if err != nil && !errors.Is(err, finerrors.ErrDivideByZero) {
+				t.Errorf("Mod() error is not ErrDivideByZero: %v", err)
+			}
+		})
+	}
+}
+
+func TestNeg(t *testing.T) {
+	tests := []struct {
+		name    string
+		a       int64
+		want    int64
+		wantErr bool
+	}{
+		{
+			name: "positive",
+			a:    42,
+			want: -42,
+		},
+		{
+			name: "negative",
+			a:    -42,
+			want: 42,
+		},
+		{
+			name: "zero",
+			a:    0,
+			want: 0,
+		},
+		{
+			name: "max int64",
+			a:    math.MaxInt64,
+			want: -math.MaxInt64,
+		},
+		{
+			name: "min int64 plus one",
+			a:    math.MinInt64 + 1,
+			want: math.MaxInt64,
+		},
+		{
+			name:    "min int64",
+			a:       math.MinInt64,
+			want:    0,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Neg(tt.a)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Neg() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Neg() = %v, want %v", got, tt.want)
+			}
+			if err != nil && !errors.Is(err, finerrors.ErrOverflow) {
+				t.Errorf("Neg() error is not ErrOverflow: %v", err)
+			}
+		})
+	}
+}
+
+func TestAbs(t *testing.T) {
+	tests := []struct {
+		name    string
+		a       int64
+		want    int64
+		wantErr bool
+	}{
+		{
+			name: "positive",
+			a:    42,
+			want: 42,
+		},
+		{
+			name: "negative",
+			a:    -42,
+			want: 42,
+		},
+		{
+			name: "zero",
+			a:    0,
+			want: 0,
+		},
+		{
+			name: "min int64 plus one",
+			a:    math.MinInt64 + 1,
+			want: math.MaxInt64,
+		},
+		{
+			name:    "min int64",
+			a:       math.MinInt64,
+			want:    0,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Abs(tt.a)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Abs() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Abs() = %v, want %v", got, tt.want)
+			}
+			if err != nil && !errors.Is(err, finerrors.ErrOverflow) {
+				t.Errorf("Abs() error is not ErrOverflow: %v", err)
+			}
+		})
+	}
+}
+
 func TestAddWithLimit(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -405,4 +657,254 @@ func TestMulWithLimit(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+
+func TestClamp(t *testing.T) {
+	tests := []struct {
+		name      string
+		v         int64
+		min       int64
+		max       int64
+		want      int64
+		wantErr   bool
+		errorType error
+	}{
+		{
+			name: "below min",
+			v:    12,
+			min:  50,
+			max:  2500,
+			want: 50,
+		},
+		{
+			name: "above max",
+			v:    3187,
+			min:  50,
+			max:  2500,
+			want: 2500,
+		},
+		{
+			name: "within range",
+			v:    725,
+			min:  50,
+			max:  2500,
+			want: 725,
+		},
+		{
+			name: "equal to bound",
+			v:    2500,
+			min:  50,
+			max:  2500,
+			want: 2500,
+		},
+		{
+			name: "full int64 range",
+			v:    math.MinInt64,
+			min:  math.MinInt64,
+			max:  math.MaxInt64,
+			want: math.MinInt64,
+		},
+		{
+			name:      "min greater than max",
+			v:         725,
+			min:       2500,
+			max:       50,
+			want:      0,
+			wantErr:   true,
+			errorType: finerrors.ErrInvalidRange,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Clamp(tt.v, tt.min, tt.max)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Clamp() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Clamp() = %v, want %v", got, tt.want)
+			}
+			if err != nil && !errors.Is(err, tt.errorType) {
+				t.Errorf("Clamp() error type = %v, want %v", err, tt.errorType)
+			}
+		})
+	}
+}
+
+func TestPrefixSums(t *testing.T) {
+	tests := []struct {
+		name      string
+		values    []int64
+		want      []int64
+		wantErr   bool
+		wantIndex string
+	}{
+		{
+			name:   "safe series",
+			values: []int64{10, -3, 25, 0, 8},
+			want:   []int64{10, 7, 32, 32, 40},
+		},
+		{
+			name:   "empty series",
+			values: []int64{},
+			want:   []int64{},
+		},
+		{
+			name:   "reaches max int64",
+			values: []int64{math.MaxInt64 - 1, 1},
+			want:   []int64{math.MaxInt64 - 1, math.MaxInt64},
+		},
+		{
+			name:      "overflow partway",
+			values:    []int64{1, math.MaxInt64 - 2, 1, 1, 5},
+			wantErr:   true,
+			wantIndex: "index 3",
+		},
+		{
+			name:      "negative overflow",
+			values:    []int64{math.MinInt64, -1},
+			wantErr:   true,
+			wantIndex: "index 1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := PrefixSums(tt.values)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PrefixSums() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				if !errors.Is(err, finerrors.ErrOverflow) {
+					t.Errorf("PrefixSums() error is not ErrOverflow: %v", err)
+				}
+				if !strings.Contains(err.Error(), tt.wantIndex) {
+					t.Errorf("PrefixSums() error = %v, want it to report %s", err, tt.wantIndex)
+				}
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("PrefixSums() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompareRatios(t *testing.T) {
+	tests := []struct {
+		name    string
+		a       int64
+		b       int64
+		c       int64
+		d       int64
+		want    int
+		wantErr bool
+	}{
+		{
+			name: "less than",
+			a:    1,
+			b:    3,
+			c:    1,
+			d:    2,
+			want: -1,
+		},
+		{
+			name: "greater than",
+			a:    2,
+			b:    3,
+			c:    1,
+			d:    2,
+			want: 1,
+		},
+		{
+			name: "equal",
+			a:    2,
+			b:    4,
+			c:    3,
+			d:    6,
+			want: 0,
+		},
+		{
+			name: "negative denominator",
+			a:    1,
+			b:    -3,
+			c:    1,
+			d:    2,
+			want: -1,
+		},
+		{
+			name: "both denominators negative",
+			a:    1,
+			b:    -3,
+			c:    1,
+			d:    -2,
+			want: 1,
+		},
+		{
+			name: "big.Int fallback greater than",
+			a:    math.MaxInt64 - 1,
+			b:    math.MaxInt64,
+			c:    math.MaxInt64 - 2,
+			d:    math.MaxInt64 - 1,
+			want: 1,
+		},
+		{
+			name: "big.Int fallback equal",
+			a:    math.MaxInt64,
+			b:    math.MaxInt64 - 1,
+			c:    math.MaxInt64,
+			d:    math.MaxInt64 - 1,
+			want: 0,
+		},
+		{
+			name: "big.Int fallback with negative values",
+			a:    math.MinInt64,
+			b:    3,
+			c:    math.MinInt64 + 1,
+			d:    3,
+			want: -1,
+		},
+		{
+			name: "big.Int fallback with min int64 denominator",
+			a:    1,
+			b:    math.MinInt64,
+			c:    math.MaxInt64,
+			d:    2,
+			want: -1,
+		},
+		{
+			name:    "zero first denominator",
+			a:       1,
+			b:       0,
+			c:       1,
+			d:       2,
+			wantErr: true,
+		},
+		{
+			name:    "zero second denominator",
+			a:       1,
+			b:       2,
+			c:       1,
+			d:       0,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CompareRatios(tt.a, tt.b, tt.c, tt.d)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CompareRatios() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil && !errors.Is(err, finerrors.ErrDivideByZero) {
+				t.Errorf("CompareRatios() error is not ErrDivideByZero: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("CompareRatios() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package safeint
+
+// MinMaxTracker tracks the smallest and largest int64 values observed in a stream.
+// The zero value is an empty tracker ready for use.
+type MinMaxTracker struct {
+	min   int64
+	max   int64
+	count uint64
+}
+
+// Observe records a value in the tracker.
+func (t *MinMaxTracker) Observe(v int64) {
+	if t.count == 0 || v < t.min {
+		t.min = v
+	}
+	if t.count == 0 || v > t.max {
+		t.max = v
+	}
+	t.count++
+}
+
+// Min returns the smallest observed value.
+// The boolean is false if no values have been observed.
+func (t *MinMaxTracker) Min() (int64, bool) {
+	return t.min, t.count > 0
+}
+
+// Max returns the largest observed value.
+// The boolean is false if no values have been observed.
+func (t *MinMaxTracker) Max() (int64, bool) {
+	return t.max, t.count > 0
+}
+
+// Count returns the number of observed values.
+func (t *MinMaxTracker) Count() uint64 {
+	return t.count
+}
@@ -0,0 +1,85 @@
+package safeint
+
+import (
+	"math"
+	"testing"
+)
+
+func TestMinMaxTracker(t *testing.T) {
+	tests := []struct {
+		name      string
+		values    []int64
+		wantMin   int64
+		wantMax   int64
+		wantCount uint64
+		wantOk    bool
+	}{
+		{
+			name:      "empty tracker",
+			values:    []int64{},
+			wantCount: 0,
+			wantOk:    false,
+		},
+		{
+			name:      "single value",
+			values:    []int64{42},
+			wantMin:   42,
+			wantMax:   42,
+			wantCount: 1,
+			wantOk:    true,
+		},
+		{
+			name:      "mixed stream",
+			values:    []int64{5, -3, 12, 0, -7, 12, 4},
+			wantMin:   -7,
+			wantMax:   12,
+			wantCount: 7,
+			wantOk:    true,
+		},
+		{
+			name:      "all negative",
+			values:    []int64{-10, -20, -5},
+			wantMin:   -20,
+			wantMax:   -5,
+			wantCount: 3,
+			wantOk:    true,
+		},
+		{
+			name:      "extreme values",
+			values:    []int64{0, math.MaxInt64, math.MinInt64},
+			wantMin:   math.MinInt64,
+			wantMax:   math.MaxInt64,
+			wantCount: 3,
+			wantOk:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var tracker MinMaxTracker
+			for _, v := range tt.values {
+				tracker.Observe(v)
+			}
+
+			gotMin, ok := tracker.Min()
+			if ok != tt.wantOk {
+				t.Errorf("Min() ok = %v, want %v", ok, tt.wantOk)
+			}
+			if ok && gotMin != tt.wantMin {
+				t.Errorf("Min() = %v, want %v", gotMin, tt.wantMin)
+			}
+
+			gotMax, ok := tracker.Max()
+			if ok != tt.wantOk {
+				t.Errorf("Max() ok = %v, want %v", ok, tt.wantOk)
+			}
+			if ok && gotMax != tt.wantMax {
+				t.Errorf("Max() = %v, want %v", gotMax, tt.wantMax)
+			}
+
+			if got := tracker.Count(); got != tt.wantCount {
+				t.Errorf("Count() = %v, want %v", got, tt.wantCount)
+			}
+		})
+	}
+}
@@ -45,6 +45,38 @@ func Mul(a, b uint64) (uint64, error) {
 	return a * b, nil
 }
 
+// AddSigned adds a signed delta to a uint64 value with overflow and underflow checking.
+// Returns an error if the result would exceed MaxUint64 or fall below zero.
+func AddSigned(a uint64, delta int64) (uint64, error) {
+	if delta >= 0 {
+		return Add(a, uint64(delta))
+	}
+	return Sub(a, negativeMagnitude(delta))
+}
+
+// AddSignedSaturating adds a signed delta to a uint64 value, clamping the result
+// to zero and MaxUint64 instead of overflowing.
+func AddSignedSaturating(a uint64, delta int64) uint64 {
+	if delta >= 0 {
+		if a > math.MaxUint64-uint64(delta) {
+			return math.MaxUint64
+		}
+		return a + uint64(delta)
+	}
+
+	magnitude := negativeMagnitude(delta)
+	if a < magnitude {
+		return 0
+	}
+	return a - magnitude
+}
+
+// negativeMagnitude returns the absolute value of a negative int64 as a uint64.
+// It is safe for math.MinInt64, whose magnitude does not fit in an int64.
+func negativeMagnitude(delta int64) uint64 {
+	return uint64(-(delta + 1)) + 1
+}
+
 // AddWithLimit performs addition with a maximum limit check.
 // Returns an error if the result exceeds the specified limit.
 func AddWithLimit(a, b, limit uint64) (uint64, error) {
@@ -201,6 +201,123 @@ func TestMul(t *testing.T) {
 	}
 }
 
+func TestAddSigned(t *testing.T) {
+	tests := []struct {
+		name    string
+		a       uint64
+		delta   int64
+		want    uint64
+		wantErr bool
+	}{
+		{
+			name:    "positive delta",
+			a:       100,
+			delta:   50,
+			want:    150,
+			wantErr: false,
+		},
+		{
+			name:    "negative delta",
+			a:       100,
+			delta:   -30,
+			want:    70,
+			wantErr: false,
+		},
+		{
+			name:    "negative delta to zero",
+			a:       100,
+			delta:   -100,
+			want:    0,
+			wantErr: false,
+		},
+		{
+			name:    "underflow",
+			a:       100,
+			delta:   -101,
+			want:    0,
+			wantErr: true,
+		},
+		{
+			name:    "min int64 delta",
+			a:       math.MaxUint64,
+			delta:   math.MinInt64,
+			want:    math.MaxUint64 - 1<<63,
+			wantErr: false,
+		},
+		{
+			name:    "overflow",
+			a:       math.MaxUint64 - 5,
+			delta:   10,
+			want:    0,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := AddSigned(tt.a, tt.delta)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("AddSigned() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("AddSigned() = %v, want %v", got, tt.want)
+			}
+			if err != nil && !errors.Is(err, finerrors.ErrOverflow) {
+				t.Errorf("AddSigned() error is not ErrOverflow: %v", err)
+			}
+		})
+	}
+}
+
+func TestAddSignedSaturating(t *testing.T) {
+	tests := []struct {
+		name  string
+		a     uint64
+		delta int64
+		want  uint64
+	}{
+		{
+			name:  "normal adjustment",
+			a:     100,
+			delta: -30,
+			want:  70,
+		},
+		{
+			name:  "clamp at zero",
+			a:     100,
+			delta: -101,
+			want:  0,
+		},
+		{
+			name:  "clamp at zero with min int64",
+			a:     5,
+			delta: math.MinInt64,
+			want:  0,
+		},
+		{
+			name:  "clamp at max",
+			a:     math.MaxUint64 - 5,
+			delta: 10,
+			want:  math.MaxUint64,
+		},
+		{
+			name:  "reach max exactly",
+			a:     math.MaxUint64 - 10,
+			delta: 10,
+			want:  math.MaxUint64,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := AddSignedSaturating(tt.a, tt.delta); got != tt.want {
+				t.Errorf("AddSignedSaturating() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestAddWithLimit(t *testing.T) {
 	tests := []struct {
 		name    string